@@ -0,0 +1,73 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"time"
+
+	"github.com/umop3plsdn/Aniweek/internal/anilist"
+	"github.com/umop3plsdn/Aniweek/internal/cache"
+	"github.com/umop3plsdn/Aniweek/internal/notify"
+)
+
+// runNotify implements `aniweek notify`: a long-running loop that polls
+// AniList on an interval and fires a desktop notification for every episode
+// that's aired since the last poll.
+func runNotify(args []string) {
+	fs := flag.NewFlagSet("notify", flag.ExitOnError)
+	interval := fs.Duration("interval", 5*time.Minute, "how often to poll AniList for newly aired episodes")
+	fs.Parse(args)
+
+	ctx := context.Background()
+	client := anilist.NewClient()
+	notifier := notify.Default()
+
+	store, err := cache.Open()
+	if err != nil {
+		fmt.Printf("Error opening local cache: %v\n", err)
+		return
+	}
+	defer store.Close()
+
+	fmt.Printf("Watching for new episodes every %s. Press Ctrl+C to stop.\n", *interval)
+	for {
+		if err := pollOnce(ctx, client, store, notifier); err != nil {
+			fmt.Printf("Error polling AniList: %v\n", err)
+		}
+		time.Sleep(*interval)
+	}
+}
+
+func pollOnce(ctx context.Context, client *anilist.Client, store *cache.Store, notifier notify.Notifier) error {
+	prev, err := store.LoadShows()
+	if err != nil {
+		return err
+	}
+	haveBaseline := !store.LastFetchedAt().IsZero()
+
+	now := time.Now().UTC()
+	raw, err := client.WeeklySchedule(ctx, now.AddDate(0, 0, -7), now)
+	if err != nil {
+		return err
+	}
+
+	if haveBaseline {
+		for _, show := range cache.NewSince(prev, raw) {
+			if !show.IsNew {
+				continue
+			}
+			event := notify.Event{
+				Title:   show.Title,
+				Episode: show.EpisodeNumber,
+				Score:   show.AverageScore,
+				SiteURL: fmt.Sprintf("https://anilist.co/anime/%d", show.MediaID),
+			}
+			if err := notifier.Notify(event); err != nil {
+				fmt.Printf("Error sending notification for %q: %v\n", show.Title, err)
+			}
+		}
+	}
+
+	return store.SaveShows(raw)
+}
@@ -0,0 +1,230 @@
+// Package anilist is a small client for the parts of the AniList GraphQL API
+// that Aniweek needs: the past week's airing schedule, and on-demand media
+// detail for the TUI's detail pane.
+package anilist
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"time"
+)
+
+const apiURL = "https://graphql.anilist.co"
+
+// Client talks to the AniList GraphQL endpoint. When Token is set, requests
+// are authenticated as that user (needed for Viewer and UserWatchList).
+type Client struct {
+	HTTPClient *http.Client
+	Token      *TokenSet
+}
+
+// NewClient returns a Client ready to use.
+func NewClient() *Client {
+	return &Client{HTTPClient: http.DefaultClient}
+}
+
+type graphQLRequest struct {
+	Query string `json:"query"`
+}
+
+type scheduleResponse struct {
+	Data struct {
+		Page struct {
+			AiringSchedules []airingSchedule `json:"airingSchedules"`
+		} `json:"Page"`
+	} `json:"data"`
+}
+
+type airingSchedule struct {
+	Episode  int `json:"episode"`
+	AiringAt int `json:"airingAt"`
+	Media    struct {
+		ID    int `json:"id"`
+		Title struct {
+			Romaji  string `json:"romaji"`
+			English string `json:"english"`
+		} `json:"title"`
+		AverageScore int `json:"averageScore"`
+	} `json:"media"`
+}
+
+// ShowInfo is a single airing episode, flattened out of the AniList response
+// into the shape every renderer in Aniweek works with.
+type ShowInfo struct {
+	MediaID       int
+	Title         string
+	EpisodeNumber int
+	AverageScore  int
+	AiringTime    time.Time
+
+	// WatchedProgress is the viewer's progress on this show, populated only
+	// in --mine mode. Nil means the show isn't on the user's tracked lists.
+	WatchedProgress *int
+
+	// IsNew is set by the cache package when this episode wasn't present in
+	// the last cached run, so renderers can badge it.
+	IsNew bool
+}
+
+// WeeklySchedule fetches the episodes that aired between since and until,
+// most recent first. Only the first page of up to 100 episodes is requested;
+// later pages are not fetched.
+func (c *Client) WeeklySchedule(ctx context.Context, since, until time.Time) ([]ShowInfo, error) {
+	query := fmt.Sprintf(`
+	{
+		Page(perPage: 100) {
+			airingSchedules(airingAt_greater: %d, airingAt_lesser: %d, sort: TIME_DESC) {
+				episode
+				airingAt
+				media {
+					id
+					title {
+						romaji
+						english
+					}
+					averageScore
+				}
+			}
+		}
+	}
+	`, since.Unix(), until.Unix())
+
+	var resp scheduleResponse
+	if err := c.do(ctx, query, &resp); err != nil {
+		return nil, err
+	}
+
+	shows := make([]ShowInfo, 0, len(resp.Data.Page.AiringSchedules))
+	for _, s := range resp.Data.Page.AiringSchedules {
+		title := s.Media.Title.English
+		if title == "" {
+			title = s.Media.Title.Romaji
+		}
+		shows = append(shows, ShowInfo{
+			MediaID:       s.Media.ID,
+			Title:         title,
+			EpisodeNumber: s.Episode,
+			AverageScore:  s.Media.AverageScore,
+			AiringTime:    time.Unix(int64(s.AiringAt), 0).UTC(),
+		})
+	}
+	return shows, nil
+}
+
+// MediaDetail is the expanded information shown in the TUI's detail pane.
+type MediaDetail struct {
+	Title          string
+	Synopsis       string
+	Genres         []string
+	CoverImageURL  string
+	SiteURL        string
+	NextAiringEp   int
+	NextAiringTime time.Time
+}
+
+type mediaDetailResponse struct {
+	Data struct {
+		Media struct {
+			Title struct {
+				Romaji  string `json:"romaji"`
+				English string `json:"english"`
+			} `json:"title"`
+			Description string   `json:"description"`
+			Genres      []string `json:"genres"`
+			CoverImage  struct {
+				Large string `json:"large"`
+			} `json:"coverImage"`
+			SiteURL      string `json:"siteUrl"`
+			NextAiringEp *struct {
+				Episode  int `json:"episode"`
+				AiringAt int `json:"airingAt"`
+			} `json:"nextAiringEpisode"`
+		} `json:"Media"`
+	} `json:"data"`
+}
+
+// MediaDetail fetches the synopsis, genres, cover art and next-airing-episode
+// information for a single media entry, used when the user opens the detail
+// pane in the TUI.
+func (c *Client) MediaDetail(ctx context.Context, mediaID int) (*MediaDetail, error) {
+	query := fmt.Sprintf(`
+	{
+		Media(id: %d) {
+			title {
+				romaji
+				english
+			}
+			description(asHtml: false)
+			genres
+			coverImage {
+				large
+			}
+			siteUrl
+			nextAiringEpisode {
+				episode
+				airingAt
+			}
+		}
+	}
+	`, mediaID)
+
+	var resp mediaDetailResponse
+	if err := c.do(ctx, query, &resp); err != nil {
+		return nil, err
+	}
+
+	m := resp.Data.Media
+	title := m.Title.English
+	if title == "" {
+		title = m.Title.Romaji
+	}
+
+	detail := &MediaDetail{
+		Title:         title,
+		Synopsis:      m.Description,
+		Genres:        m.Genres,
+		CoverImageURL: m.CoverImage.Large,
+		SiteURL:       m.SiteURL,
+	}
+	if m.NextAiringEp != nil {
+		detail.NextAiringEp = m.NextAiringEp.Episode
+		detail.NextAiringTime = time.Unix(int64(m.NextAiringEp.AiringAt), 0).UTC()
+	}
+	return detail, nil
+}
+
+func (c *Client) do(ctx context.Context, query string, out interface{}) error {
+	body, err := json.Marshal(graphQLRequest{Query: query})
+	if err != nil {
+		return fmt.Errorf("encoding graphql request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, apiURL, bytes.NewBuffer(body))
+	if err != nil {
+		return fmt.Errorf("building request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if c.Token != nil && c.Token.AccessToken != "" {
+		req.Header.Set("Authorization", "Bearer "+c.Token.AccessToken)
+	}
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("making request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("reading response: %w", err)
+	}
+
+	if err := json.Unmarshal(respBody, out); err != nil {
+		return fmt.Errorf("parsing response: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,259 @@
+package anilist
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+)
+
+const (
+	oauthAuthorizeURL = "https://anilist.co/api/v2/oauth/authorize"
+	oauthTokenURL     = "https://anilist.co/api/v2/oauth/token"
+	redirectHost      = "127.0.0.1:14271"
+	redirectPath      = "/callback"
+
+	// loginTimeout bounds how long Login waits for the browser redirect, so a
+	// caller passing context.Background() (no deadline of its own) can't hang
+	// forever if the user never completes the consent screen.
+	loginTimeout = 5 * time.Minute
+)
+
+// clientID is Aniweek's public AniList OAuth client ID, registered at
+// https://anilist.co/settings/developer.
+const clientID = "26661"
+
+// TokenSet is the cached AniList OAuth credential, persisted so `--mine`
+// doesn't need a browser round-trip on every run.
+type TokenSet struct {
+	AccessToken  string    `json:"access_token"`
+	RefreshToken string    `json:"refresh_token"`
+	ExpiresAt    time.Time `json:"expires_at"`
+}
+
+// Expired reports whether the access token is past (or close to) expiry.
+func (t TokenSet) Expired() bool {
+	return t.AccessToken == "" || time.Now().After(t.ExpiresAt.Add(-1*time.Minute))
+}
+
+// TokenPath returns the path Aniweek caches the AniList token under:
+// $XDG_CONFIG_HOME/aniweek/token.json, falling back to ~/.config.
+func TokenPath() (string, error) {
+	configHome := os.Getenv("XDG_CONFIG_HOME")
+	if configHome == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", fmt.Errorf("resolving home directory: %w", err)
+		}
+		configHome = filepath.Join(home, ".config")
+	}
+	return filepath.Join(configHome, "aniweek", "token.json"), nil
+}
+
+// LoadToken reads the cached token from disk, if any.
+func LoadToken() (*TokenSet, error) {
+	path, err := TokenPath()
+	if err != nil {
+		return nil, err
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var token TokenSet
+	if err := json.Unmarshal(data, &token); err != nil {
+		return nil, fmt.Errorf("parsing cached token: %w", err)
+	}
+	return &token, nil
+}
+
+// SaveToken persists the token to $XDG_CONFIG_HOME/aniweek/token.json.
+func SaveToken(token *TokenSet) error {
+	path, err := TokenPath()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return fmt.Errorf("creating config dir: %w", err)
+	}
+	data, err := json.MarshalIndent(token, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encoding token: %w", err)
+	}
+	return ioutil.WriteFile(path, data, 0o600)
+}
+
+// Login runs the AniList OAuth2 PKCE authorization-code flow: it opens the
+// user's browser to the AniList consent screen, catches the redirect on a
+// loopback HTTP server, exchanges the code for a token, and caches it.
+func (c *Client) Login(ctx context.Context) (*TokenSet, error) {
+	verifier, challenge, err := newPKCEPair()
+	if err != nil {
+		return nil, fmt.Errorf("generating PKCE challenge: %w", err)
+	}
+
+	code, err := awaitAuthorizationCode(ctx, challenge)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := c.exchangeCode(ctx, code, verifier)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := SaveToken(token); err != nil {
+		return nil, fmt.Errorf("caching token: %w", err)
+	}
+	c.Token = token
+	return token, nil
+}
+
+// RefreshToken exchanges a cached refresh token for a new access token.
+func (c *Client) RefreshToken(ctx context.Context, token *TokenSet) (*TokenSet, error) {
+	form := url.Values{
+		"grant_type":    {"refresh_token"},
+		"client_id":     {clientID},
+		"refresh_token": {token.RefreshToken},
+		"redirect_uri":  {"http://" + redirectHost + redirectPath},
+	}
+
+	newToken, err := c.requestToken(ctx, form)
+	if err != nil {
+		return nil, err
+	}
+	if err := SaveToken(newToken); err != nil {
+		return nil, fmt.Errorf("caching refreshed token: %w", err)
+	}
+	c.Token = newToken
+	return newToken, nil
+}
+
+func (c *Client) exchangeCode(ctx context.Context, code, verifier string) (*TokenSet, error) {
+	form := url.Values{
+		"grant_type":    {"authorization_code"},
+		"client_id":     {clientID},
+		"code":          {code},
+		"code_verifier": {verifier},
+		"redirect_uri":  {"http://" + redirectHost + redirectPath},
+	}
+	return c.requestToken(ctx, form)
+}
+
+func (c *Client) requestToken(ctx context.Context, form url.Values) (*TokenSet, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, oauthTokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("building token request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("requesting token: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var payload struct {
+		AccessToken  string `json:"access_token"`
+		RefreshToken string `json:"refresh_token"`
+		ExpiresIn    int    `json:"expires_in"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&payload); err != nil {
+		return nil, fmt.Errorf("parsing token response: %w", err)
+	}
+	if payload.AccessToken == "" {
+		return nil, fmt.Errorf("anilist did not return an access token")
+	}
+
+	return &TokenSet{
+		AccessToken:  payload.AccessToken,
+		RefreshToken: payload.RefreshToken,
+		ExpiresAt:    time.Now().Add(time.Duration(payload.ExpiresIn) * time.Second),
+	}, nil
+}
+
+// awaitAuthorizationCode opens the system browser to the AniList consent
+// screen and blocks until the redirect lands on our loopback listener.
+func awaitAuthorizationCode(ctx context.Context, challenge string) (string, error) {
+	authorizeURL := fmt.Sprintf(
+		"%s?client_id=%s&redirect_uri=%s&response_type=code&code_challenge=%s&code_challenge_method=S256",
+		oauthAuthorizeURL, clientID, url.QueryEscape("http://"+redirectHost+redirectPath), challenge,
+	)
+
+	codeCh := make(chan string, 1)
+	errCh := make(chan error, 1)
+
+	mux := http.NewServeMux()
+	server := &http.Server{Addr: redirectHost, Handler: mux}
+	mux.HandleFunc(redirectPath, func(w http.ResponseWriter, r *http.Request) {
+		code := r.URL.Query().Get("code")
+		if code == "" {
+			errCh <- fmt.Errorf("anilist redirected without an authorization code")
+			fmt.Fprintln(w, "Login failed, you can close this tab.")
+			return
+		}
+		fmt.Fprintln(w, "Logged in to Aniweek, you can close this tab.")
+		codeCh <- code
+	})
+
+	go func() {
+		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			errCh <- fmt.Errorf("starting loopback callback server: %w", err)
+		}
+	}()
+	defer server.Close()
+
+	openBrowser(authorizeURL)
+	fmt.Printf("Opening browser to log in to AniList. If it didn't open, visit:\n%s\n", authorizeURL)
+
+	timeout := time.NewTimer(loginTimeout)
+	defer timeout.Stop()
+
+	select {
+	case code := <-codeCh:
+		return code, nil
+	case err := <-errCh:
+		return "", err
+	case <-ctx.Done():
+		return "", ctx.Err()
+	case <-timeout.C:
+		return "", fmt.Errorf("timed out after %s waiting for the AniList login redirect", loginTimeout)
+	}
+}
+
+func newPKCEPair() (verifier, challenge string, err error) {
+	raw := make([]byte, 32)
+	if _, err = rand.Read(raw); err != nil {
+		return "", "", err
+	}
+	verifier = base64.RawURLEncoding.EncodeToString(raw)
+
+	sum := sha256.Sum256([]byte(verifier))
+	challenge = base64.RawURLEncoding.EncodeToString(sum[:])
+	return verifier, challenge, nil
+}
+
+func openBrowser(target string) {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.Command("open", target)
+	case "windows":
+		cmd = exec.Command("rundll32", "url.dll,FileProtocolHandler", target)
+	default:
+		cmd = exec.Command("xdg-open", target)
+	}
+	_ = cmd.Start()
+}
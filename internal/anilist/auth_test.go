@@ -0,0 +1,37 @@
+package anilist
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"testing"
+)
+
+func TestNewPKCEPairChallengeMatchesVerifier(t *testing.T) {
+	verifier, challenge, err := newPKCEPair()
+	if err != nil {
+		t.Fatalf("newPKCEPair returned error: %v", err)
+	}
+	if verifier == "" || challenge == "" {
+		t.Fatalf("expected non-empty verifier/challenge, got %q / %q", verifier, challenge)
+	}
+
+	sum := sha256.Sum256([]byte(verifier))
+	want := base64.RawURLEncoding.EncodeToString(sum[:])
+	if challenge != want {
+		t.Errorf("challenge = %q, want S256(verifier) = %q", challenge, want)
+	}
+}
+
+func TestNewPKCEPairIsRandomPerCall(t *testing.T) {
+	v1, _, err := newPKCEPair()
+	if err != nil {
+		t.Fatalf("newPKCEPair returned error: %v", err)
+	}
+	v2, _, err := newPKCEPair()
+	if err != nil {
+		t.Fatalf("newPKCEPair returned error: %v", err)
+	}
+	if v1 == v2 {
+		t.Error("two calls to newPKCEPair produced the same verifier")
+	}
+}
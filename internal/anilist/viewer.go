@@ -0,0 +1,129 @@
+package anilist
+
+import (
+	"context"
+	"fmt"
+)
+
+// Viewer is the logged-in AniList user.
+type Viewer struct {
+	ID   int
+	Name string
+}
+
+type viewerResponse struct {
+	Data struct {
+		Viewer struct {
+			ID   int    `json:"id"`
+			Name string `json:"name"`
+		} `json:"Viewer"`
+	} `json:"data"`
+}
+
+// Viewer fetches the currently authenticated user. It requires Client.Token
+// to be set (see Login/RefreshToken).
+func (c *Client) Viewer(ctx context.Context) (*Viewer, error) {
+	const query = `{ Viewer { id name } }`
+
+	var resp viewerResponse
+	if err := c.do(ctx, query, &resp); err != nil {
+		return nil, err
+	}
+	return &Viewer{ID: resp.Data.Viewer.ID, Name: resp.Data.Viewer.Name}, nil
+}
+
+// WatchListEntry is one entry of a user's MediaListCollection: a show they're
+// tracking, plus their personal progress on it.
+type WatchListEntry struct {
+	MediaID  int
+	Title    string
+	Progress int
+	Status   string
+}
+
+type watchListResponse struct {
+	Data struct {
+		MediaListCollection struct {
+			Lists []struct {
+				Entries []struct {
+					Progress int    `json:"progress"`
+					Status   string `json:"status"`
+					Media    struct {
+						ID    int `json:"id"`
+						Title struct {
+							Romaji  string `json:"romaji"`
+							English string `json:"english"`
+						} `json:"title"`
+					} `json:"media"`
+				} `json:"entries"`
+			} `json:"lists"`
+		} `json:"MediaListCollection"`
+	} `json:"data"`
+}
+
+// UserWatchList fetches every entry in the user's list with the given status
+// (e.g. "CURRENT" or "PLANNING").
+func (c *Client) UserWatchList(ctx context.Context, userID int, status string) ([]WatchListEntry, error) {
+	query := fmt.Sprintf(`
+	{
+		MediaListCollection(userId: %d, type: ANIME, status: %s) {
+			lists {
+				entries {
+					progress
+					status
+					media {
+						id
+						title {
+							romaji
+							english
+						}
+					}
+				}
+			}
+		}
+	}
+	`, userID, status)
+
+	var resp watchListResponse
+	if err := c.do(ctx, query, &resp); err != nil {
+		return nil, err
+	}
+
+	var entries []WatchListEntry
+	for _, list := range resp.Data.MediaListCollection.Lists {
+		for _, e := range list.Entries {
+			title := e.Media.Title.English
+			if title == "" {
+				title = e.Media.Title.Romaji
+			}
+			entries = append(entries, WatchListEntry{
+				MediaID:  e.Media.ID,
+				Title:    title,
+				Progress: e.Progress,
+				Status:   e.Status,
+			})
+		}
+	}
+	return entries, nil
+}
+
+// RestrictToWatchList keeps only the shows present in watchList, annotating
+// each with the viewer's progress, for the --mine flag.
+func RestrictToWatchList(shows []ShowInfo, watchList []WatchListEntry) []ShowInfo {
+	progressByMediaID := make(map[int]int, len(watchList))
+	for _, entry := range watchList {
+		progressByMediaID[entry.MediaID] = entry.Progress
+	}
+
+	mine := make([]ShowInfo, 0, len(shows))
+	for _, show := range shows {
+		progress, ok := progressByMediaID[show.MediaID]
+		if !ok {
+			continue
+		}
+		show.WatchedProgress = &progress
+		mine = append(mine, show)
+	}
+	return mine
+}
+
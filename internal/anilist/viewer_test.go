@@ -0,0 +1,37 @@
+package anilist
+
+import "testing"
+
+func TestRestrictToWatchList(t *testing.T) {
+	shows := []ShowInfo{
+		{MediaID: 1, Title: "Frieren"},
+		{MediaID: 2, Title: "Dandadan"},
+		{MediaID: 3, Title: "Not on my list"},
+	}
+	watchList := []WatchListEntry{
+		{MediaID: 1, Progress: 5},
+		{MediaID: 2, Progress: 0},
+	}
+
+	got := RestrictToWatchList(shows, watchList)
+
+	if len(got) != 2 {
+		t.Fatalf("got %d shows, want 2: %+v", len(got), got)
+	}
+	if got[0].MediaID != 1 || got[0].WatchedProgress == nil || *got[0].WatchedProgress != 5 {
+		t.Errorf("shows[0] = %+v, want MediaID 1 with WatchedProgress 5", got[0])
+	}
+	if got[1].MediaID != 2 || got[1].WatchedProgress == nil || *got[1].WatchedProgress != 0 {
+		t.Errorf("shows[1] = %+v, want MediaID 2 with WatchedProgress 0", got[1])
+	}
+}
+
+func TestRestrictToWatchListEmptyWatchListDropsEverything(t *testing.T) {
+	shows := []ShowInfo{{MediaID: 1, Title: "Frieren"}}
+
+	got := RestrictToWatchList(shows, nil)
+
+	if len(got) != 0 {
+		t.Fatalf("got %d shows, want 0: %+v", len(got), got)
+	}
+}
@@ -0,0 +1,155 @@
+// Package cache persists the weekly AniList schedule to a local bbolt store
+// so Aniweek can serve something instantly on a cold or flaky connection,
+// and so repeat runs can tell which episodes are new since the last look.
+package cache
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+
+	"github.com/umop3plsdn/Aniweek/internal/anilist"
+)
+
+var showsBucket = []byte("shows")
+var metaBucket = []byte("meta")
+
+const lastFetchedKey = "last_fetched_at"
+
+// Store is a local cache of the last successfully fetched weekly schedule.
+type Store struct {
+	db *bolt.DB
+}
+
+// Path returns the path Aniweek caches the schedule under:
+// $XDG_CACHE_HOME/aniweek/cache.db, falling back to ~/.cache.
+func Path() (string, error) {
+	cacheHome := os.Getenv("XDG_CACHE_HOME")
+	if cacheHome == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", fmt.Errorf("resolving home directory: %w", err)
+		}
+		cacheHome = filepath.Join(home, ".cache")
+	}
+	return filepath.Join(cacheHome, "aniweek", "cache.db"), nil
+}
+
+// Open opens (creating if necessary) the local cache store.
+func Open() (*Store, error) {
+	path, err := Path()
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return nil, fmt.Errorf("creating cache dir: %w", err)
+	}
+
+	db, err := bolt.Open(path, 0o600, &bolt.Options{Timeout: 1 * time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("opening cache db: %w", err)
+	}
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		if _, err := tx.CreateBucketIfNotExists(showsBucket); err != nil {
+			return err
+		}
+		_, err := tx.CreateBucketIfNotExists(metaBucket)
+		return err
+	})
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("initializing cache buckets: %w", err)
+	}
+
+	return &Store{db: db}, nil
+}
+
+// Close releases the underlying database file.
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+// Key uniquely identifies an episode in the cache.
+func Key(show anilist.ShowInfo) string {
+	return fmt.Sprintf("%d:%d", show.MediaID, show.EpisodeNumber)
+}
+
+// LoadShows returns whatever schedule was cached by the last SaveShows call,
+// or an empty slice if the cache is empty.
+func (s *Store) LoadShows() ([]anilist.ShowInfo, error) {
+	var shows []anilist.ShowInfo
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(showsBucket).ForEach(func(_, value []byte) error {
+			var show anilist.ShowInfo
+			if err := json.Unmarshal(value, &show); err != nil {
+				return fmt.Errorf("decoding cached show: %w", err)
+			}
+			shows = append(shows, show)
+			return nil
+		})
+	})
+	return shows, err
+}
+
+// SaveShows replaces the cached schedule with shows, becoming the new
+// baseline that future NewSince calls diff against.
+func (s *Store) SaveShows(shows []anilist.ShowInfo) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		if err := tx.DeleteBucket(showsBucket); err != nil {
+			return fmt.Errorf("clearing cached shows: %w", err)
+		}
+		bucket, err := tx.CreateBucket(showsBucket)
+		if err != nil {
+			return fmt.Errorf("recreating shows bucket: %w", err)
+		}
+		for _, show := range shows {
+			data, err := json.Marshal(show)
+			if err != nil {
+				return fmt.Errorf("encoding show: %w", err)
+			}
+			if err := bucket.Put([]byte(Key(show)), data); err != nil {
+				return err
+			}
+		}
+		return tx.Bucket(metaBucket).Put([]byte(lastFetchedKey), []byte(time.Now().UTC().Format(time.RFC3339)))
+	})
+}
+
+// LastFetchedAt returns when SaveShows was last called, or the zero time if
+// the cache has never been populated.
+func (s *Store) LastFetchedAt() time.Time {
+	var t time.Time
+	_ = s.db.View(func(tx *bolt.Tx) error {
+		raw := tx.Bucket(metaBucket).Get([]byte(lastFetchedKey))
+		if raw == nil {
+			return nil
+		}
+		parsed, err := time.Parse(time.RFC3339, string(raw))
+		if err == nil {
+			t = parsed
+		}
+		return nil
+	})
+	return t
+}
+
+// NewSince marks every show in fresh whose key wasn't present in stale as
+// IsNew, so renderers can badge episodes that appeared since the last run.
+func NewSince(stale, fresh []anilist.ShowInfo) []anilist.ShowInfo {
+	staleKeys := make(map[string]bool, len(stale))
+	for _, show := range stale {
+		staleKeys[Key(show)] = true
+	}
+
+	annotated := make([]anilist.ShowInfo, len(fresh))
+	for i, show := range fresh {
+		show.IsNew = !staleKeys[Key(show)]
+		annotated[i] = show
+	}
+	return annotated
+}
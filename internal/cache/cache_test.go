@@ -0,0 +1,65 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/umop3plsdn/Aniweek/internal/anilist"
+)
+
+func show(mediaID, episode int) anilist.ShowInfo {
+	return anilist.ShowInfo{
+		MediaID:       mediaID,
+		Title:         "Show",
+		EpisodeNumber: episode,
+		AiringTime:    time.Now(),
+	}
+}
+
+func TestNewSince(t *testing.T) {
+	tests := []struct {
+		name    string
+		stale   []anilist.ShowInfo
+		fresh   []anilist.ShowInfo
+		wantNew []bool // parallel to fresh
+	}{
+		{
+			name:    "empty stale marks everything new",
+			stale:   nil,
+			fresh:   []anilist.ShowInfo{show(1, 1), show(2, 1)},
+			wantNew: []bool{true, true},
+		},
+		{
+			name:    "unchanged episode is not new",
+			stale:   []anilist.ShowInfo{show(1, 1)},
+			fresh:   []anilist.ShowInfo{show(1, 1)},
+			wantNew: []bool{false},
+		},
+		{
+			name:    "next episode of a known show is new",
+			stale:   []anilist.ShowInfo{show(1, 1)},
+			fresh:   []anilist.ShowInfo{show(1, 1), show(1, 2)},
+			wantNew: []bool{false, true},
+		},
+		{
+			name:    "same episode number on a different media is new",
+			stale:   []anilist.ShowInfo{show(1, 1)},
+			fresh:   []anilist.ShowInfo{show(2, 1)},
+			wantNew: []bool{true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewSince(tt.stale, tt.fresh)
+			if len(got) != len(tt.wantNew) {
+				t.Fatalf("got %d shows, want %d", len(got), len(tt.wantNew))
+			}
+			for i, show := range got {
+				if show.IsNew != tt.wantNew[i] {
+					t.Errorf("shows[%d].IsNew = %v, want %v", i, show.IsNew, tt.wantNew[i])
+				}
+			}
+		})
+	}
+}
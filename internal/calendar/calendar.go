@@ -0,0 +1,152 @@
+// Package calendar renders a week of episodes as a 7-column day-by-day
+// heatmap, an alternative to the chronological list view for getting a
+// genuine at-a-glance overview of the week.
+package calendar
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/umop3plsdn/Aniweek/internal/anilist"
+	"github.com/umop3plsdn/Aniweek/internal/style"
+)
+
+// cellWidth is the rendered width of one renderCell column: Width(18), which
+// already includes its 1-column-each-side padding, plus the border.
+const cellWidth = 20
+
+// Calendar groups a week of shows by the day they aired.
+type Calendar struct {
+	byDay map[Date][]anilist.ShowInfo
+	from  Date
+	to    Date
+}
+
+// New buckets shows by airing day and records the earliest/latest day seen,
+// so Render can walk the whole range and fill in days with no episodes.
+func New(shows []anilist.ShowInfo) Calendar {
+	c := Calendar{byDay: make(map[Date][]anilist.ShowInfo)}
+
+	for _, show := range shows {
+		day := NewDate(show.AiringTime)
+		c.byDay[day] = append(c.byDay[day], show)
+
+		if c.from == (Date{}) || day.Cmp(c.from) < 0 {
+			c.from = day
+		}
+		if c.to == (Date{}) || day.Cmp(c.to) > 0 {
+			c.to = day
+		}
+	}
+
+	return c
+}
+
+// Render draws the calendar at the given terminal width, falling back to a
+// vertical list when the grid wouldn't fit.
+func (c Calendar) Render(width int) string {
+	if len(c.byDay) == 0 {
+		return style.AppStyle.Render("✨ No new episodes aired in the past week ✨")
+	}
+	if width < len(c.days())*cellWidth {
+		return style.AppStyle.Render(c.renderList())
+	}
+	return style.AppStyle.Render(c.renderGrid())
+}
+
+func (c Calendar) days() []Date {
+	var days []Date
+	for d := c.from; d.Cmp(c.to) <= 0; d = d.AddDays(1) {
+		days = append(days, d)
+	}
+	return days
+}
+
+func (c Calendar) renderGrid() string {
+	columns := make([]string, 0, 7)
+	for _, day := range c.days() {
+		columns = append(columns, c.renderCell(day))
+	}
+	return lipgloss.JoinHorizontal(lipgloss.Top, columns...)
+}
+
+func (c Calendar) renderCell(day Date) string {
+	shows := c.byDay[day]
+
+	header := style.DayHeaderStyle.Render(day.Weekday().String()[:3]) + "\n" +
+		style.TimeStyle.Render(day.String())
+
+	var body strings.Builder
+	for _, show := range shows {
+		body.WriteString(fmt.Sprintf("%s %s\n", style.ScoreEmoji(show.AverageScore), show.Title))
+	}
+	if len(shows) == 0 {
+		body.WriteString(style.NoScoreStyle.Render("—"))
+	}
+
+	cellStyle := lipgloss.NewStyle().
+		Width(18).
+		Padding(0, 1).
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(borderColor(averageScore(shows))).
+		Background(heatColor(len(shows)))
+
+	return cellStyle.Render(header + "\n" + style.DividerStyle.String() + "\n" + body.String())
+}
+
+func (c Calendar) renderList() string {
+	var b strings.Builder
+	for _, day := range c.days() {
+		shows := c.byDay[day]
+		b.WriteString(style.DayHeaderStyle.Render("📺 " + day.String()) + "\n")
+		if len(shows) == 0 {
+			b.WriteString(style.NoScoreStyle.Render("No episodes") + "\n")
+			continue
+		}
+		for _, show := range shows {
+			b.WriteString(style.ShowEntryStyle.Render(
+				style.ScoreEmoji(show.AverageScore)+" "+style.TitleStyle.Render(show.Title)+
+					style.EpisodeStyle.Render(fmt.Sprintf(" Ep %d", show.EpisodeNumber)),
+			) + "\n")
+		}
+	}
+	return b.String()
+}
+
+// averageScore returns the mean AniList average score across shows, or 0.
+func averageScore(shows []anilist.ShowInfo) int {
+	if len(shows) == 0 {
+		return 0
+	}
+	total := 0
+	for _, show := range shows {
+		total += show.AverageScore
+	}
+	return total / len(shows)
+}
+
+// borderColor mirrors style.ScoreEmoji's thresholds, so "good day" coloring
+// stays consistent with the rest of Aniweek.
+func borderColor(score int) lipgloss.Color {
+	switch {
+	case score > 75:
+		return style.HighlightColor
+	case score == 0:
+		return style.SubtleColor
+	default:
+		return style.AccentColor
+	}
+}
+
+// heatColor picks a background shade whose intensity tracks episode count:
+// more episodes airing that day, a brighter cell.
+func heatColor(episodeCount int) lipgloss.Color {
+	shades := []string{"#1a1025", "#2c1a42", "#3e2460", "#502e7e", "#63389c"}
+	i := episodeCount
+	if i >= len(shades) {
+		i = len(shades) - 1
+	}
+	return lipgloss.Color(shades[i])
+}
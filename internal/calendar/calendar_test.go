@@ -0,0 +1,25 @@
+package calendar
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/umop3plsdn/Aniweek/internal/anilist"
+)
+
+func TestRenderFallsBackToListBelowGridWidth(t *testing.T) {
+	c := New([]anilist.ShowInfo{
+		{Title: "Frieren", EpisodeNumber: 5, AiringTime: time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)},
+	})
+
+	days := len(c.days())
+	gridWidth := days * cellWidth
+
+	if got := c.Render(gridWidth - 1); !strings.Contains(got, "📺") {
+		t.Errorf("Render(%d) for a %d-day week did not fall back to the list view", gridWidth-1, days)
+	}
+	if got := c.Render(gridWidth); strings.Contains(got, "📺") {
+		t.Errorf("Render(%d) for a %d-day week unexpectedly used the list view", gridWidth, days)
+	}
+}
@@ -0,0 +1,49 @@
+package calendar
+
+import "time"
+
+// Date is a calendar day with no time-of-day component, used to bucket
+// episodes by the day they aired regardless of exact airing time.
+type Date struct {
+	Year  int
+	Month time.Month
+	Day   int
+}
+
+// NewDate truncates t down to its calendar day, in t's own location.
+func NewDate(t time.Time) Date {
+	return Date{Year: t.Year(), Month: t.Month(), Day: t.Day()}
+}
+
+// Time returns the Date as a time.Time at midnight UTC.
+func (d Date) Time() time.Time {
+	return time.Date(d.Year, d.Month, d.Day, 0, 0, 0, 0, time.UTC)
+}
+
+// Weekday returns which day of the week d falls on.
+func (d Date) Weekday() time.Weekday {
+	return d.Time().Weekday()
+}
+
+// AddDays returns the date n days after d (n may be negative).
+func (d Date) AddDays(n int) Date {
+	return NewDate(d.Time().AddDate(0, 0, n))
+}
+
+// Cmp returns -1, 0, or 1 as d is before, equal to, or after other.
+func (d Date) Cmp(other Date) int {
+	dt, ot := d.Time(), other.Time()
+	switch {
+	case dt.Before(ot):
+		return -1
+	case dt.After(ot):
+		return 1
+	default:
+		return 0
+	}
+}
+
+// String formats the date as "Mon Jan 02".
+func (d Date) String() string {
+	return d.Time().Format("Mon Jan 02")
+}
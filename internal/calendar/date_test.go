@@ -0,0 +1,48 @@
+package calendar
+
+import "testing"
+
+func TestDateAddDays(t *testing.T) {
+	tests := []struct {
+		name string
+		in   Date
+		n    int
+		want Date
+	}{
+		{"same month", Date{2026, 3, 10}, 3, Date{2026, 3, 13}},
+		{"rolls into next month", Date{2026, 3, 30}, 3, Date{2026, 4, 2}},
+		{"rolls into next year", Date{2026, 12, 30}, 3, Date{2027, 1, 2}},
+		{"leap day rollover", Date{2024, 2, 28}, 1, Date{2024, 2, 29}},
+		{"non-leap february rollover", Date{2026, 2, 28}, 1, Date{2026, 3, 1}},
+		{"negative n rolls backward across month", Date{2026, 3, 1}, -1, Date{2026, 2, 28}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.in.AddDays(tt.n); got != tt.want {
+				t.Errorf("%+v.AddDays(%d) = %+v, want %+v", tt.in, tt.n, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDateCmp(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b Date
+		want int
+	}{
+		{"equal", Date{2026, 3, 10}, Date{2026, 3, 10}, 0},
+		{"before within month", Date{2026, 3, 9}, Date{2026, 3, 10}, -1},
+		{"after across month boundary", Date{2026, 4, 1}, Date{2026, 3, 31}, 1},
+		{"before across year boundary", Date{2025, 12, 31}, Date{2026, 1, 1}, -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.a.Cmp(tt.b); got != tt.want {
+				t.Errorf("%+v.Cmp(%+v) = %d, want %d", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package export
+
+import (
+	"encoding/csv"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/umop3plsdn/Aniweek/internal/anilist"
+)
+
+// CSV emits "media_id,day,title,episode,score,airing_time,watched_progress,
+// is_new" rows suitable for spreadsheets.
+type CSV struct{}
+
+func (CSV) Encode(shows []anilist.ShowInfo) (string, error) {
+	var sb strings.Builder
+	w := csv.NewWriter(&sb)
+
+	if err := w.Write([]string{"media_id", "day", "title", "episode", "score", "airing_time", "watched_progress", "is_new"}); err != nil {
+		return "", err
+	}
+	for _, show := range shows {
+		watchedProgress := ""
+		if show.WatchedProgress != nil {
+			watchedProgress = strconv.Itoa(*show.WatchedProgress)
+		}
+		row := []string{
+			strconv.Itoa(show.MediaID),
+			show.AiringTime.Format("Monday"),
+			show.Title,
+			strconv.Itoa(show.EpisodeNumber),
+			strconv.Itoa(show.AverageScore),
+			show.AiringTime.Format(time.RFC3339),
+			watchedProgress,
+			strconv.FormatBool(show.IsNew),
+		}
+		if err := w.Write(row); err != nil {
+			return "", err
+		}
+	}
+
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return "", err
+	}
+	return sb.String(), nil
+}
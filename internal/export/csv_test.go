@@ -0,0 +1,53 @@
+package export
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/umop3plsdn/Aniweek/internal/anilist"
+)
+
+func TestCSVEncode(t *testing.T) {
+	progress := 4
+	shows := []anilist.ShowInfo{
+		{
+			MediaID:         1,
+			Title:           "Attack on Titan, Final Season",
+			EpisodeNumber:   5,
+			AverageScore:    91,
+			AiringTime:      time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC),
+			WatchedProgress: &progress,
+			IsNew:           true,
+		},
+		{
+			MediaID:       2,
+			Title:         "Dandadan",
+			EpisodeNumber: 1,
+			AiringTime:    time.Date(2026, 3, 11, 12, 0, 0, 0, time.UTC),
+		},
+	}
+
+	got, err := CSV{}.Encode(shows)
+	if err != nil {
+		t.Fatalf("Encode returned error: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimRight(got, "\n"), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("got %d lines, want 3 (header + 2 rows):\n%s", len(lines), got)
+	}
+	if lines[0] != "media_id,day,title,episode,score,airing_time,watched_progress,is_new" {
+		t.Errorf("unexpected header: %q", lines[0])
+	}
+	// A title containing a comma must come back quoted per RFC 4180.
+	if !strings.Contains(lines[1], `"Attack on Titan, Final Season"`) {
+		t.Errorf("comma in title was not quoted: %q", lines[1])
+	}
+	if !strings.HasSuffix(lines[1], "4,true") {
+		t.Errorf("watched progress/is_new missing from row: %q", lines[1])
+	}
+	if !strings.HasSuffix(lines[2], ",false") {
+		t.Errorf("expected empty watched_progress and is_new=false: %q", lines[2])
+	}
+}
@@ -0,0 +1,31 @@
+// Package export turns a week of shows into a string in some output format,
+// for piping into other tools. New formats plug in by implementing Encoder.
+package export
+
+import (
+	"fmt"
+
+	"github.com/umop3plsdn/Aniweek/internal/anilist"
+)
+
+// Encoder renders a week of shows as a single string.
+type Encoder interface {
+	Encode(shows []anilist.ShowInfo) (string, error)
+}
+
+// ForName resolves a --output flag value to its Encoder, or reports an
+// unknown format.
+func ForName(name string) (Encoder, error) {
+	switch name {
+	case "json":
+		return JSON{}, nil
+	case "csv":
+		return CSV{}, nil
+	case "ical":
+		return ICal{}, nil
+	case "plain":
+		return Plain{}, nil
+	default:
+		return nil, fmt.Errorf("unknown output format %q (want plain, json, csv, or ical)", name)
+	}
+}
@@ -0,0 +1,42 @@
+package export
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/umop3plsdn/Aniweek/internal/anilist"
+)
+
+// ICal emits a VCALENDAR with one VEVENT per episode, so the week can be
+// subscribed to from Google Calendar, Thunderbird, and the like.
+type ICal struct{}
+
+func (ICal) Encode(shows []anilist.ShowInfo) (string, error) {
+	var b strings.Builder
+	b.WriteString("BEGIN:VCALENDAR\r\n")
+	b.WriteString("VERSION:2.0\r\n")
+	b.WriteString("PRODID:-//Aniweek//EN\r\n")
+
+	for _, show := range shows {
+		b.WriteString("BEGIN:VEVENT\r\n")
+		fmt.Fprintf(&b, "UID:%d-%d@aniweek\r\n", show.MediaID, show.EpisodeNumber)
+		fmt.Fprintf(&b, "DTSTART:%s\r\n", show.AiringTime.UTC().Format("20060102T150405Z"))
+		fmt.Fprintf(&b, "SUMMARY:%s Ep %d\r\n", icalEscape(show.Title), show.EpisodeNumber)
+		fmt.Fprintf(&b, "URL:https://anilist.co/anime/%d\r\n", show.MediaID)
+		b.WriteString("END:VEVENT\r\n")
+	}
+
+	b.WriteString("END:VCALENDAR\r\n")
+	return b.String(), nil
+}
+
+// icalEscape escapes the characters iCalendar text values must have escaped.
+func icalEscape(s string) string {
+	replacer := strings.NewReplacer(
+		`\`, `\\`,
+		`,`, `\,`,
+		`;`, `\;`,
+		"\n", `\n`,
+	)
+	return replacer.Replace(s)
+}
@@ -0,0 +1,25 @@
+package export
+
+import "testing"
+
+func TestICalEscape(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"plain", "Frieren", "Frieren"},
+		{"backslash", `C:\path`, `C:\\path`},
+		{"comma", "Attack on Titan, Final Season", `Attack on Titan\, Final Season`},
+		{"semicolon", "Foo; Bar", `Foo\; Bar`},
+		{"all special chars", `a\b,c;d`, `a\\b\,c\;d`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := icalEscape(tt.in); got != tt.want {
+				t.Errorf("icalEscape(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
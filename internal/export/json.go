@@ -0,0 +1,42 @@
+package export
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/umop3plsdn/Aniweek/internal/anilist"
+)
+
+// JSON emits the week as a JSON array, with airing times in RFC3339.
+type JSON struct{}
+
+type jsonShow struct {
+	MediaID         int    `json:"media_id"`
+	Title           string `json:"title"`
+	EpisodeNumber   int    `json:"episode"`
+	AverageScore    int    `json:"average_score"`
+	AiringTime      string `json:"airing_time"`
+	WatchedProgress *int   `json:"watched_progress,omitempty"`
+	IsNew           bool   `json:"is_new"`
+}
+
+func (JSON) Encode(shows []anilist.ShowInfo) (string, error) {
+	out := make([]jsonShow, len(shows))
+	for i, show := range shows {
+		out[i] = jsonShow{
+			MediaID:         show.MediaID,
+			Title:           show.Title,
+			EpisodeNumber:   show.EpisodeNumber,
+			AverageScore:    show.AverageScore,
+			AiringTime:      show.AiringTime.Format(time.RFC3339),
+			WatchedProgress: show.WatchedProgress,
+			IsNew:           show.IsNew,
+		}
+	}
+
+	data, err := json.MarshalIndent(out, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
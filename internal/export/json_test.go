@@ -0,0 +1,47 @@
+package export
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/umop3plsdn/Aniweek/internal/anilist"
+)
+
+func TestJSONEncodePreservesMineAndNewFields(t *testing.T) {
+	progress := 4
+	shows := []anilist.ShowInfo{
+		{
+			MediaID:         1,
+			Title:           "Frieren",
+			EpisodeNumber:   5,
+			AverageScore:    91,
+			AiringTime:      time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC),
+			WatchedProgress: &progress,
+			IsNew:           true,
+		},
+	}
+
+	got, err := JSON{}.Encode(shows)
+	if err != nil {
+		t.Fatalf("Encode returned error: %v", err)
+	}
+
+	for _, want := range []string{`"media_id": 1`, `"watched_progress": 4`, `"is_new": true`} {
+		if !strings.Contains(got, want) {
+			t.Errorf("output missing %q:\n%s", want, got)
+		}
+	}
+}
+
+func TestJSONEncodeOmitsWatchedProgressWhenNil(t *testing.T) {
+	shows := []anilist.ShowInfo{{MediaID: 1, Title: "Frieren", EpisodeNumber: 5}}
+
+	got, err := JSON{}.Encode(shows)
+	if err != nil {
+		t.Fatalf("Encode returned error: %v", err)
+	}
+	if strings.Contains(got, "watched_progress") {
+		t.Errorf("expected watched_progress to be omitted when nil:\n%s", got)
+	}
+}
@@ -0,0 +1,14 @@
+package export
+
+import (
+	"github.com/umop3plsdn/Aniweek/internal/anilist"
+	"github.com/umop3plsdn/Aniweek/internal/render"
+)
+
+// Plain wraps Aniweek's original lipgloss-styled chronological list as an
+// Encoder, so it composes with the other output formats.
+type Plain struct{}
+
+func (Plain) Encode(shows []anilist.ShowInfo) (string, error) {
+	return render.Plain(shows), nil
+}
@@ -0,0 +1,34 @@
+package notify
+
+import "os/exec"
+
+// DarwinNotifier prefers terminal-notifier (supports a click action URL) and
+// falls back to the AppleScript `osascript` notifier, which every macOS
+// install has but which can't open a URL on click.
+type DarwinNotifier struct{}
+
+func (DarwinNotifier) Notify(e Event) error {
+	if _, err := exec.LookPath("terminal-notifier"); err == nil {
+		args := []string{"-title", "Aniweek", "-subtitle", "New episode", "-message", e.Summary()}
+		if e.SiteURL != "" {
+			args = append(args, "-open", e.SiteURL)
+		}
+		return exec.Command("terminal-notifier", args...).Run()
+	}
+
+	return exec.Command("osascript", osascriptArgs(e)...).Run()
+}
+
+// osascriptArgs builds the osascript invocation that raises a notification
+// via AppleScript. The summary is passed as an argv item rather than
+// interpolated into the script text: "on run argv" reads it back as a plain
+// string value, so there's no AppleScript string literal for an
+// attacker-controlled title to break out of.
+func osascriptArgs(e Event) []string {
+	return []string{
+		"-e", "on run argv",
+		"-e", `display notification (item 1 of argv) with title "Aniweek" subtitle "New episode"`,
+		"-e", "end run",
+		e.Summary(),
+	}
+}
@@ -0,0 +1,32 @@
+package notify
+
+import "testing"
+
+func TestOsascriptArgsPassesSummaryUnescaped(t *testing.T) {
+	tests := []struct {
+		name    string
+		summary string
+	}{
+		{"plain", "Frieren Ep 5 (★ 91/100)"},
+		{"embedded double quote", `Foo" ; do shell script "rm -rf ~" #`},
+		{"embedded backslash", `Foo\bar`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := Event{Title: tt.summary, Episode: 0}
+			args := osascriptArgs(e)
+
+			last := args[len(args)-1]
+			if last != e.Summary() {
+				t.Errorf("last arg = %q, want the untouched summary %q", last, e.Summary())
+			}
+
+			for _, arg := range args[:len(args)-1] {
+				if arg == tt.summary {
+					t.Fatalf("summary leaked into a script argument: %q", arg)
+				}
+			}
+		})
+	}
+}
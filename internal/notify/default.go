@@ -0,0 +1,25 @@
+package notify
+
+import (
+	"os"
+	"runtime"
+)
+
+// Default picks the Notifier for the current platform. Setting
+// ANIWEEK_NOTIFIER=jsonlines always selects JSONLinesNotifier on stdout,
+// which is how Wayland/Hyprland users wire Aniweek into their own
+// notification daemon.
+func Default() Notifier {
+	if os.Getenv("ANIWEEK_NOTIFIER") == "jsonlines" {
+		return JSONLinesNotifier{Writer: os.Stdout}
+	}
+
+	switch runtime.GOOS {
+	case "darwin":
+		return DarwinNotifier{}
+	case "windows":
+		return WindowsNotifier{}
+	default:
+		return LinuxNotifier{}
+	}
+}
@@ -0,0 +1,30 @@
+package notify
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// JSONLinesNotifier writes one JSON object per event, so users on
+// Wayland/Hyprland (or anyone who'd rather script their own notification
+// daemon) can pipe Aniweek's events wherever they like, mako-history-style.
+type JSONLinesNotifier struct {
+	Writer io.Writer
+}
+
+type jsonLinesEvent struct {
+	Title   string `json:"title"`
+	Episode int    `json:"episode"`
+	Score   int    `json:"score"`
+	SiteURL string `json:"site_url"`
+}
+
+func (n JSONLinesNotifier) Notify(e Event) error {
+	enc := json.NewEncoder(n.Writer)
+	return enc.Encode(jsonLinesEvent{
+		Title:   e.Title,
+		Episode: e.Episode,
+		Score:   e.Score,
+		SiteURL: e.SiteURL,
+	})
+}
@@ -0,0 +1,12 @@
+package notify
+
+import "os/exec"
+
+// LinuxNotifier shells out to notify-send (libnotify), the standard
+// freedesktop notification mechanism.
+type LinuxNotifier struct{}
+
+func (LinuxNotifier) Notify(e Event) error {
+	args := []string{"--app-name=Aniweek", "New episode", e.Summary()}
+	return exec.Command("notify-send", args...).Run()
+}
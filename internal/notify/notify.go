@@ -0,0 +1,29 @@
+// Package notify turns newly-aired episodes into desktop notifications,
+// dispatched through whichever mechanism fits the host OS (or a JSON-lines
+// stream for users who want to pipe events into their own notification
+// daemon).
+package notify
+
+import "fmt"
+
+// Event is a single newly-aired episode worth notifying about.
+type Event struct {
+	Title   string
+	Episode int
+	Score   int
+	SiteURL string
+}
+
+// Notifier delivers a notification for a newly-aired episode.
+type Notifier interface {
+	Notify(Event) error
+}
+
+// Summary renders the one-line text every Notifier implementation shares:
+// "Title Ep N (★ score/100)".
+func (e Event) Summary() string {
+	if e.Score > 0 {
+		return fmt.Sprintf("%s Ep %d (★ %d/100)", e.Title, e.Episode, e.Score)
+	}
+	return fmt.Sprintf("%s Ep %d", e.Title, e.Episode)
+}
@@ -0,0 +1,47 @@
+package notify
+
+import (
+	"encoding/base64"
+	"fmt"
+	"os/exec"
+	"strings"
+	"unicode/utf16"
+)
+
+// WindowsNotifier shells out to the BurntToast PowerShell module, the
+// de facto way to raise a native toast from a script on Windows.
+type WindowsNotifier struct{}
+
+func (WindowsNotifier) Notify(e Event) error {
+	script := fmt.Sprintf("New-BurntToastNotification -Text 'Aniweek', %s", psSingleQuote(e.Summary()))
+	return exec.Command("powershell", "-NoProfile", "-EncodedCommand", encodeCommand(script)).Run()
+}
+
+// psSingleQuote quotes s as a PowerShell single-quoted string literal, where
+// the only special characters are the single quote and its typographic
+// variants (U+2018 to U+201B), which PowerShell also treats as quotes; each
+// is doubled to escape it.
+func psSingleQuote(s string) string {
+	return "'" + psQuoteEscaper.Replace(s) + "'"
+}
+
+var psQuoteEscaper = strings.NewReplacer(
+	"'", "''",
+	"‘", "‘‘",
+	"’", "’’",
+	"‚", "‚‚",
+	"‛", "‛‛",
+)
+
+// encodeCommand base64-encodes script as UTF-16LE, the format -EncodedCommand
+// requires. Passing the script this way instead of interpolating it into
+// -Command avoids a second layer of command-line quoting: attacker-controlled
+// text (episode/show titles) only ever sits inside the single-quoted literal
+// that psSingleQuote builds.
+func encodeCommand(script string) string {
+	var buf []byte
+	for _, unit := range utf16.Encode([]rune(script)) {
+		buf = append(buf, byte(unit), byte(unit>>8))
+	}
+	return base64.StdEncoding.EncodeToString(buf)
+}
@@ -0,0 +1,49 @@
+package notify
+
+import (
+	"encoding/base64"
+	"testing"
+	"unicode/utf16"
+)
+
+func TestPsSingleQuote(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"plain", "Frieren Ep 5", "'Frieren Ep 5'"},
+		{"embedded single quote", "Foo' ; calc.exe #", "'Foo'' ; calc.exe #'"},
+		{"embedded double quote is untouched", `Foo" ; calc.exe #`, `'Foo" ; calc.exe #'`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := psSingleQuote(tt.in); got != tt.want {
+				t.Errorf("psSingleQuote(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEncodeCommand(t *testing.T) {
+	script := `New-BurntToastNotification -Text 'Aniweek', 'Foo'' ; calc.exe #'`
+
+	encoded := encodeCommand(script)
+
+	raw, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		t.Fatalf("encodeCommand produced invalid base64: %v", err)
+	}
+	if len(raw)%2 != 0 {
+		t.Fatalf("decoded bytes have odd length %d, not valid UTF-16LE", len(raw))
+	}
+
+	units := make([]uint16, len(raw)/2)
+	for i := range units {
+		units[i] = uint16(raw[2*i]) | uint16(raw[2*i+1])<<8
+	}
+	if got := string(utf16.Decode(units)); got != script {
+		t.Errorf("round-tripped script = %q, want %q", got, script)
+	}
+}
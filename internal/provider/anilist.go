@@ -0,0 +1,17 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/umop3plsdn/Aniweek/internal/anilist"
+)
+
+// AniListProvider is the original AniList GraphQL data source.
+type AniListProvider struct {
+	Client *anilist.Client
+}
+
+func (p AniListProvider) WeeklySchedule(ctx context.Context, since, until time.Time) ([]anilist.ShowInfo, error) {
+	return p.Client.WeeklySchedule(ctx, since, until)
+}
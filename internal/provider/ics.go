@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/umop3plsdn/Aniweek/internal/anilist"
+)
+
+// ICSProvider reads a hand-curated iCalendar file or URL, letting users
+// maintain their own schedule independent of any tracker. Each VEVENT is
+// expected to have a SUMMARY of the form "Title Ep N", matching the format
+// Aniweek's own --output=ical export produces.
+type ICSProvider struct {
+	Source string // local file path, or an http(s) URL
+}
+
+var summaryEpisodeRe = regexp.MustCompile(`^(.*)\s+Ep\s+(\d+)$`)
+
+// icalUnescaper undoes iCalendar TEXT escaping in a SUMMARY value.
+var icalUnescaper = strings.NewReplacer(`\\`, `\`, `\,`, `,`, `\;`, `;`, `\n`, "\n", `\N`, "\n")
+
+func (p ICSProvider) WeeklySchedule(ctx context.Context, since, until time.Time) ([]anilist.ShowInfo, error) {
+	reader, err := p.open(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	var shows []anilist.ShowInfo
+	var summary string
+	var airingAt time.Time
+	inEvent := false
+
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == "BEGIN:VEVENT":
+			inEvent = true
+			summary = ""
+			airingAt = time.Time{}
+		case line == "END:VEVENT":
+			if inEvent && !airingAt.IsZero() {
+				if show, ok := parseSummary(summary, airingAt); ok {
+					if !show.AiringTime.Before(since) && show.AiringTime.Before(until) {
+						shows = append(shows, show)
+					}
+				}
+			}
+			inEvent = false
+		case inEvent && strings.HasPrefix(line, "SUMMARY:"):
+			summary = icalUnescaper.Replace(strings.TrimPrefix(line, "SUMMARY:"))
+		case inEvent && strings.HasPrefix(line, "DTSTART"):
+			if idx := strings.Index(line, ":"); idx != -1 {
+				parsed, err := time.Parse("20060102T150405Z", line[idx+1:])
+				if err == nil {
+					airingAt = parsed
+				}
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading ics feed: %w", err)
+	}
+
+	return shows, nil
+}
+
+func parseSummary(summary string, airingAt time.Time) (anilist.ShowInfo, bool) {
+	matches := summaryEpisodeRe.FindStringSubmatch(summary)
+	if matches == nil {
+		return anilist.ShowInfo{Title: summary, AiringTime: airingAt}, summary != ""
+	}
+	episode, err := strconv.Atoi(matches[2])
+	if err != nil {
+		return anilist.ShowInfo{}, false
+	}
+	return anilist.ShowInfo{
+		Title:         matches[1],
+		EpisodeNumber: episode,
+		AiringTime:    airingAt,
+	}, true
+}
+
+func (p ICSProvider) open(ctx context.Context) (io.ReadCloser, error) {
+	if strings.HasPrefix(p.Source, "http://") || strings.HasPrefix(p.Source, "https://") {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.Source, nil)
+		if err != nil {
+			return nil, fmt.Errorf("building ics request: %w", err)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return nil, fmt.Errorf("fetching ics feed: %w", err)
+		}
+		return resp.Body, nil
+	}
+
+	f, err := os.Open(p.Source)
+	if err != nil {
+		return nil, fmt.Errorf("opening ics file: %w", err)
+	}
+	return f, nil
+}
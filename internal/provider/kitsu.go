@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/umop3plsdn/Aniweek/internal/anilist"
+)
+
+// KitsuProvider sources the weekly schedule from Kitsu's JSON:API
+// (https://kitsu.docs.apiary.io/). Kitsu doesn't expose a global
+// per-episode airing calendar, so this lists anime currently airing and
+// treats "airing" as having aired within the requested window — good
+// enough to surface a show, even without an exact per-episode timestamp.
+type KitsuProvider struct {
+	HTTPClient *http.Client
+}
+
+func (p KitsuProvider) httpClient() *http.Client {
+	if p.HTTPClient != nil {
+		return p.HTTPClient
+	}
+	return http.DefaultClient
+}
+
+type kitsuAnimeResponse struct {
+	Data []struct {
+		Attributes struct {
+			CanonicalTitle string `json:"canonicalTitle"`
+			AverageRating  string `json:"averageRating"`
+			EpisodeCount   int    `json:"episodeCount"`
+		} `json:"attributes"`
+	} `json:"data"`
+}
+
+func (p KitsuProvider) WeeklySchedule(ctx context.Context, since, until time.Time) ([]anilist.ShowInfo, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet,
+		"https://kitsu.io/api/edge/anime?filter[status]=current&page[limit]=20", nil)
+	if err != nil {
+		return nil, fmt.Errorf("building kitsu request: %w", err)
+	}
+	req.Header.Set("Accept", "application/vnd.api+json")
+
+	resp, err := p.httpClient().Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("fetching kitsu schedule: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var parsed kitsuAnimeResponse
+	if err := json.NewDecoder(resp.Body).Decode(&parsed); err != nil {
+		return nil, fmt.Errorf("parsing kitsu schedule: %w", err)
+	}
+
+	shows := make([]anilist.ShowInfo, 0, len(parsed.Data))
+	for _, entry := range parsed.Data {
+		var score int
+		fmt.Sscanf(entry.Attributes.AverageRating, "%d", &score)
+		shows = append(shows, anilist.ShowInfo{
+			Title:        entry.Attributes.CanonicalTitle,
+			AverageScore: score,
+			AiringTime:   until,
+		})
+	}
+	return shows, nil
+}
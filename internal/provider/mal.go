@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/umop3plsdn/Aniweek/internal/anilist"
+)
+
+// MALProvider sources the weekly schedule from MyAnimeList via the Jikan
+// REST API (https://docs.api.jikan.moe/). Jikan's /schedules endpoint only
+// knows a show's recurring weekly broadcast slot, not per-episode airing
+// timestamps, so episode numbers aren't available here the way they are
+// from AniList or a curated ICS feed.
+type MALProvider struct {
+	HTTPClient *http.Client
+}
+
+func (p MALProvider) httpClient() *http.Client {
+	if p.HTTPClient != nil {
+		return p.HTTPClient
+	}
+	return http.DefaultClient
+}
+
+type jikanSchedulesResponse struct {
+	Data []struct {
+		Title     string  `json:"title"`
+		Score     float64 `json:"score"`
+		URL       string  `json:"url"`
+		Broadcast struct {
+			Day  string `json:"day"`
+			Time string `json:"time"`
+		} `json:"broadcast"`
+	} `json:"data"`
+}
+
+// WeeklySchedule walks each day in [since, until), asking Jikan which shows
+// are scheduled to broadcast on that weekday.
+func (p MALProvider) WeeklySchedule(ctx context.Context, since, until time.Time) ([]anilist.ShowInfo, error) {
+	var shows []anilist.ShowInfo
+
+	for day := since; day.Before(until); day = day.AddDate(0, 0, 1) {
+		weekday := day.Weekday().String()
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet,
+			fmt.Sprintf("https://api.jikan.moe/v4/schedules?filter=%s", weekday), nil)
+		if err != nil {
+			return nil, fmt.Errorf("building jikan request: %w", err)
+		}
+
+		resp, err := p.httpClient().Do(req)
+		if err != nil {
+			return nil, fmt.Errorf("fetching jikan schedule for %s: %w", weekday, err)
+		}
+
+		var parsed jikanSchedulesResponse
+		err = json.NewDecoder(resp.Body).Decode(&parsed)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("parsing jikan schedule for %s: %w", weekday, err)
+		}
+
+		for _, entry := range parsed.Data {
+			shows = append(shows, anilist.ShowInfo{
+				Title:        entry.Title,
+				AverageScore: int(entry.Score * 10),
+				AiringTime:   day,
+			})
+		}
+	}
+
+	return shows, nil
+}
@@ -0,0 +1,73 @@
+// Package provider abstracts "where the weekly schedule comes from" so
+// Aniweek isn't locked to AniList: MyAnimeList, Kitsu, and a user-curated
+// iCalendar file are all valid sources, and more than one can be combined.
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/umop3plsdn/Aniweek/internal/anilist"
+)
+
+// Provider fetches the episodes that aired in [since, until) from one data
+// source.
+type Provider interface {
+	WeeklySchedule(ctx context.Context, since, until time.Time) ([]anilist.ShowInfo, error)
+}
+
+// Parse builds the chain of providers named in spec, a comma-separated list
+// like "anilist,ics". icsSource is the file path or URL used by the ics
+// provider, if requested.
+func Parse(spec string, client *anilist.Client, icsSource string) ([]Provider, error) {
+	names := strings.Split(spec, ",")
+	providers := make([]Provider, 0, len(names))
+
+	for _, name := range names {
+		switch strings.TrimSpace(strings.ToLower(name)) {
+		case "anilist":
+			providers = append(providers, AniListProvider{Client: client})
+		case "mal":
+			providers = append(providers, MALProvider{})
+		case "kitsu":
+			providers = append(providers, KitsuProvider{})
+		case "ics":
+			if icsSource == "" {
+				return nil, fmt.Errorf("--provider=ics requires --ics=<file or URL>")
+			}
+			providers = append(providers, ICSProvider{Source: icsSource})
+		default:
+			return nil, fmt.Errorf("unknown provider %q (want anilist, mal, kitsu, or ics)", name)
+		}
+	}
+	return providers, nil
+}
+
+// FetchAll runs every provider and merges the results, de-duplicating
+// episodes that multiple providers agree on by (title, episode).
+func FetchAll(ctx context.Context, providers []Provider, since, until time.Time) ([]anilist.ShowInfo, error) {
+	var merged []anilist.ShowInfo
+	seen := make(map[string]bool)
+
+	for _, p := range providers {
+		shows, err := p.WeeklySchedule(ctx, since, until)
+		if err != nil {
+			return nil, err
+		}
+		for _, show := range shows {
+			key := dedupeKey(show)
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			merged = append(merged, show)
+		}
+	}
+	return merged, nil
+}
+
+func dedupeKey(show anilist.ShowInfo) string {
+	return strings.ToLower(show.Title) + "|" + fmt.Sprint(show.EpisodeNumber)
+}
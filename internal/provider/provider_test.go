@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/umop3plsdn/Aniweek/internal/anilist"
+)
+
+type fakeProvider struct {
+	shows []anilist.ShowInfo
+	err   error
+}
+
+func (f fakeProvider) WeeklySchedule(ctx context.Context, since, until time.Time) ([]anilist.ShowInfo, error) {
+	return f.shows, f.err
+}
+
+func TestFetchAllDedupesByTitleAndEpisode(t *testing.T) {
+	anilistProvider := fakeProvider{shows: []anilist.ShowInfo{
+		{Title: "Frieren", EpisodeNumber: 5},
+		{Title: "Dandadan", EpisodeNumber: 1},
+	}}
+	icsProvider := fakeProvider{shows: []anilist.ShowInfo{
+		{Title: "frieren", EpisodeNumber: 5}, // same show, different case: dropped
+		{Title: "Frieren", EpisodeNumber: 6}, // new episode: kept
+	}}
+
+	got, err := FetchAll(context.Background(), []Provider{anilistProvider, icsProvider}, time.Time{}, time.Time{})
+	if err != nil {
+		t.Fatalf("FetchAll returned error: %v", err)
+	}
+
+	want := []anilist.ShowInfo{
+		{Title: "Frieren", EpisodeNumber: 5},
+		{Title: "Dandadan", EpisodeNumber: 1},
+		{Title: "Frieren", EpisodeNumber: 6},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %d shows, want %d: %+v", len(got), len(want), got)
+	}
+	for i := range want {
+		if got[i].Title != want[i].Title || got[i].EpisodeNumber != want[i].EpisodeNumber {
+			t.Errorf("shows[%d] = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestFetchAllPropagatesProviderError(t *testing.T) {
+	failing := fakeProvider{err: errors.New("boom")}
+
+	_, err := FetchAll(context.Background(), []Provider{failing}, time.Time{}, time.Time{})
+	if err == nil {
+		t.Fatal("expected error from failing provider, got nil")
+	}
+}
@@ -0,0 +1,95 @@
+// Package render prints a week of shows to stdout the same way the original
+// one-shot Aniweek did, for use behind --output=plain and for any scripting-style
+// invocation that doesn't want the interactive TUI.
+package render
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/umop3plsdn/Aniweek/internal/anilist"
+	"github.com/umop3plsdn/Aniweek/internal/style"
+)
+
+// ByDay groups shows by the UTC calendar day they aired on.
+func ByDay(shows []anilist.ShowInfo) map[time.Time][]anilist.ShowInfo {
+	showsByDay := make(map[time.Time][]anilist.ShowInfo)
+	for _, show := range shows {
+		day := time.Date(show.AiringTime.Year(), show.AiringTime.Month(), show.AiringTime.Day(), 0, 0, 0, 0, time.UTC)
+		showsByDay[day] = append(showsByDay[day], show)
+	}
+	return showsByDay
+}
+
+// Plain renders the week as the original chronological, day-grouped list.
+func Plain(shows []anilist.ShowInfo) string {
+	showsByDay := ByDay(shows)
+	if len(showsByDay) == 0 {
+		return style.AppStyle.Render("✨ No new episodes aired in the past week ✨")
+	}
+
+	var output strings.Builder
+
+	days := make([]time.Time, 0, len(showsByDay))
+	for day := range showsByDay {
+		days = append(days, day)
+	}
+	sort.Slice(days, func(i, j int) bool {
+		return days[i].After(days[j])
+	})
+
+	for i, day := range days {
+		dayShows := showsByDay[day]
+		dayFormatted := day.Format("Monday (Jan 02)")
+
+		header := style.DayHeaderStyle.Render("📺 " + dayFormatted)
+		output.WriteString(header + "\n")
+		output.WriteString(style.DividerStyle.String() + "\n")
+
+		for _, show := range dayShows {
+			entry := style.ShowEntryStyle.Render(
+				style.ScoreEmoji(show.AverageScore) + " " +
+					style.TitleStyle.Render(show.Title) +
+					lipgloss.NewStyle().Foreground(style.SubtleColor).Render(" • ") +
+					style.EpisodeStyle.Render(fmt.Sprintf("Ep %d", show.EpisodeNumber)) +
+					style.TimeStyle.Render(fmt.Sprintf(" 🕒 %s", show.AiringTime.Format("3:04 PM"))) +
+					style.RenderScore(show.AverageScore) +
+					progressSuffix(show) +
+					newBadge(show),
+			)
+			output.WriteString(entry + "\n")
+		}
+
+		if i < len(days)-1 {
+			output.WriteString("\n")
+		}
+	}
+
+	return style.AppStyle.Render(output.String())
+}
+
+// progressSuffix renders "(watched N)" for --mine mode, where the viewer's
+// progress on a show is known.
+func progressSuffix(show anilist.ShowInfo) string {
+	if show.WatchedProgress == nil {
+		return ""
+	}
+	return style.TimeStyle.Render(fmt.Sprintf(" (watched %d)", *show.WatchedProgress))
+}
+
+// newBadge flags an episode that wasn't in the cache on the last run.
+func newBadge(show anilist.ShowInfo) string {
+	if !show.IsNew {
+		return ""
+	}
+	return style.EpisodeStyle.Render(" NEW")
+}
+
+// Error renders a user-facing error the same way the original CLI did.
+func Error(context string, err error) string {
+	return style.ErrorStyle.Render(fmt.Sprintf("%s: %v", context, err))
+}
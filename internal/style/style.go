@@ -0,0 +1,101 @@
+// Package style holds the shared Neon Charm lipgloss palette and styles used
+// by every renderer (plain output, the calendar view, and the TUI) so the
+// look stays consistent no matter how the data ends up on screen.
+package style
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Neon Charm-inspired color palette
+var (
+	PrimaryColor   = lipgloss.Color("#FF5FEF") // Neon pink
+	SecondaryColor = lipgloss.Color("#00F8D4") // Electric teal
+	AccentColor    = lipgloss.Color("#BD93FF") // Neon purple
+	TextColor      = lipgloss.AdaptiveColor{Light: "#E0E0E0", Dark: "#E0E0E0"}
+	SubtleColor    = lipgloss.Color("#A0A0A0")
+	HighlightColor = lipgloss.Color("#FFB86C") // Neon peach
+	ErrorColor     = lipgloss.Color("#FF6B6B")
+)
+
+// Shared styles
+var (
+	TitleStyle = lipgloss.NewStyle().
+			Foreground(PrimaryColor).
+			Bold(true).
+			MarginRight(1)
+
+	EpisodeStyle = lipgloss.NewStyle().
+			Foreground(SecondaryColor).
+			Bold(true)
+
+	TimeStyle = lipgloss.NewStyle().
+			Foreground(SubtleColor).
+			PaddingLeft(1)
+
+	ScoreStyle = lipgloss.NewStyle().
+			Foreground(HighlightColor).
+			PaddingLeft(1)
+
+	NoScoreStyle = lipgloss.NewStyle().
+			Foreground(SubtleColor).
+			Italic(true).
+			PaddingLeft(1)
+
+	DayHeaderStyle = lipgloss.NewStyle().
+			Foreground(AccentColor).
+			Bold(true).
+			MarginTop(1).
+			Underline(true).
+			PaddingBottom(0)
+
+	DividerStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.AdaptiveColor{Light: "#D9D9D9", Dark: "#444"}).
+			SetString("╌╌╌╌╌╌╌╌╌╌╌╌╌╌╌╌╌╌╌╌╌╌╌╌╌╌╌")
+
+	ShowEntryStyle = lipgloss.NewStyle().
+			PaddingLeft(2).
+			MarginBottom(0)
+
+	AppStyle = lipgloss.NewStyle().
+			Padding(1, 2).
+			Border(lipgloss.RoundedBorder()).
+			BorderForeground(lipgloss.AdaptiveColor{Light: "#BD93FF", Dark: "#BD93FF"}).
+			Foreground(TextColor)
+
+	ErrorStyle = lipgloss.NewStyle().
+			Foreground(ErrorColor).
+			Bold(true)
+)
+
+// ScoreEmoji picks the emoji used to badge a show's average score.
+func ScoreEmoji(score int) string {
+	switch {
+	case score > 75:
+		return "🌟"
+	case score == 0:
+		return "📡"
+	default:
+		return "✨"
+	}
+}
+
+// RenderScore renders a show's average score, or a muted placeholder when
+// AniList hasn't got enough ratings yet.
+func RenderScore(score int) string {
+	if score > 0 {
+		return ScoreStyle.Render(ScoreLabel(score))
+	}
+	return NoScoreStyle.Render("★ No rating")
+}
+
+// ScoreLabel formats the raw "★ NN/100" text without any styling applied,
+// for callers (like RenderScore) that need the plain string.
+func ScoreLabel(score int) string {
+	if score <= 0 {
+		return "★ No rating"
+	}
+	return fmt.Sprintf("★ %d/100", score)
+}
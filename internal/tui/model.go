@@ -0,0 +1,190 @@
+// Package tui is the interactive Bubble Tea application for Aniweek: a
+// scrollable list of the past week's episodes with filtering, sorting, and a
+// detail pane for the selected show.
+package tui
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/umop3plsdn/Aniweek/internal/anilist"
+)
+
+// SortMode picks which field the list is ordered by.
+type SortMode int
+
+const (
+	SortByTime SortMode = iota
+	SortByScore
+	SortByTitle
+)
+
+// FilterMode restricts which shows are visible.
+type FilterMode int
+
+const (
+	FilterNone FilterMode = iota
+	FilterSearch
+)
+
+// RefreshFunc fetches an up-to-date schedule, diffed and annotated against
+// whatever was displayed before, in the background.
+type RefreshFunc func(stale []anilist.ShowInfo) ([]anilist.ShowInfo, error)
+
+// Model is the root Bubble Tea model for the weekly schedule.
+type Model struct {
+	client  *anilist.Client
+	refresh RefreshFunc
+
+	shows      []anilist.ShowInfo
+	filtered   []anilist.ShowInfo
+	refreshErr error
+
+	cursor       int
+	sortMode     SortMode
+	filterMode   FilterMode
+	searchInput  string
+	minScore     int
+	watchingOnly bool
+
+	showDetail bool
+	detailShow anilist.ShowInfo
+	detail     *anilist.MediaDetail
+	detailErr  error
+	loading    bool
+
+	width, height int
+	err           error
+}
+
+// New builds a Model that shows shows instantly (which may be stale cache
+// data) and, if refresh is non-nil, kicks off a background refetch whose
+// result replaces them once it lands.
+func New(client *anilist.Client, shows []anilist.ShowInfo, refresh RefreshFunc) Model {
+	m := Model{
+		client:  client,
+		shows:   shows,
+		refresh: refresh,
+	}
+	m.applyFilterAndSort()
+	return m
+}
+
+func (m Model) Init() tea.Cmd {
+	if m.refresh == nil {
+		return nil
+	}
+	return m.fetchRefresh()
+}
+
+// detailMsg carries the result of fetching a show's expanded detail.
+// mediaID identifies which show the fetch was for, so a response that
+// arrives after the user has moved on to a different show can be ignored
+// instead of overwriting it.
+type detailMsg struct {
+	mediaID int
+	detail  *anilist.MediaDetail
+	err     error
+}
+
+// refreshMsg carries the result of a background schedule refresh.
+type refreshMsg struct {
+	shows []anilist.ShowInfo
+	err   error
+}
+
+func (m Model) fetchRefresh() tea.Cmd {
+	refresh := m.refresh
+	stale := m.shows
+	return func() tea.Msg {
+		shows, err := refresh(stale)
+		return refreshMsg{shows: shows, err: err}
+	}
+}
+
+func (m *Model) fetchDetail(mediaID int) tea.Cmd {
+	client := m.client
+	return func() tea.Msg {
+		detail, err := client.MediaDetail(context.Background(), mediaID)
+		return detailMsg{mediaID: mediaID, detail: detail, err: err}
+	}
+}
+
+// applyFilterAndSort recomputes m.filtered from m.shows given the current
+// filter and sort settings. The cursor is clamped to stay in range.
+func (m *Model) applyFilterAndSort() {
+	filtered := make([]anilist.ShowInfo, 0, len(m.shows))
+	for _, show := range m.shows {
+		if show.AverageScore < m.minScore {
+			continue
+		}
+		if m.filterMode == FilterSearch && m.searchInput != "" {
+			if !strings.Contains(strings.ToLower(show.Title), strings.ToLower(m.searchInput)) {
+				continue
+			}
+		}
+		if m.watchingOnly && show.WatchedProgress == nil {
+			continue
+		}
+		filtered = append(filtered, show)
+	}
+
+	switch m.sortMode {
+	case SortByScore:
+		sort.SliceStable(filtered, func(i, j int) bool {
+			return filtered[i].AverageScore > filtered[j].AverageScore
+		})
+	case SortByTitle:
+		sort.SliceStable(filtered, func(i, j int) bool {
+			return strings.ToLower(filtered[i].Title) < strings.ToLower(filtered[j].Title)
+		})
+	default: // SortByTime
+		sort.SliceStable(filtered, func(i, j int) bool {
+			return filtered[i].AiringTime.After(filtered[j].AiringTime)
+		})
+	}
+
+	m.filtered = filtered
+	if m.cursor >= len(m.filtered) {
+		m.cursor = len(m.filtered) - 1
+	}
+	if m.cursor < 0 {
+		m.cursor = 0
+	}
+
+	// The detail pane is pinned to m.detailShow's MediaID, not m.cursor, so a
+	// list replacement (a background refresh landing) can't leave it showing
+	// one show's title over another's synopsis. Follow the pinned show to its
+	// new position, or close the pane if it dropped out of the filtered list.
+	if m.showDetail {
+		if idx := indexByMediaID(m.filtered, m.detailShow.MediaID); idx >= 0 {
+			m.cursor = idx
+			m.detailShow = m.filtered[idx]
+		} else {
+			m.showDetail = false
+			m.detail = nil
+			m.detailErr = nil
+		}
+	}
+}
+
+// indexByMediaID returns the index of the show with the given MediaID in
+// shows, or -1 if it isn't present.
+func indexByMediaID(shows []anilist.ShowInfo, mediaID int) int {
+	for i, show := range shows {
+		if show.MediaID == mediaID {
+			return i
+		}
+	}
+	return -1
+}
+
+// Run starts the Bubble Tea program and blocks until the user quits.
+func Run(client *anilist.Client, shows []anilist.ShowInfo, refresh RefreshFunc) error {
+	p := tea.NewProgram(New(client, shows, refresh), tea.WithAltScreen())
+	_, err := p.Run()
+	return err
+}
@@ -0,0 +1,104 @@
+package tui
+
+import (
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/umop3plsdn/Aniweek/internal/anilist"
+)
+
+func openDetail(m Model, mediaID int) Model {
+	for i, show := range m.filtered {
+		if show.MediaID == mediaID {
+			m.cursor = i
+			break
+		}
+	}
+	m.showDetail = true
+	m.detailShow = m.filtered[m.cursor]
+	m.loading = true
+	return m
+}
+
+func TestRefreshFollowsPinnedDetailShowAcrossListReplacement(t *testing.T) {
+	shows := []anilist.ShowInfo{
+		{MediaID: 1, Title: "Frieren", AverageScore: 90, AiringTime: time.Now()},
+		{MediaID: 2, Title: "Dandadan", AverageScore: 40, AiringTime: time.Now()},
+	}
+	m := New(nil, shows, nil)
+	m = openDetail(m, 2)
+
+	refreshed := []anilist.ShowInfo{
+		{MediaID: 2, Title: "Dandadan", AverageScore: 40, AiringTime: time.Now(), IsNew: true},
+		{MediaID: 1, Title: "Frieren", AverageScore: 90, AiringTime: time.Now()},
+	}
+	next, _ := m.Update(refreshMsg{shows: refreshed})
+	got := next.(Model)
+
+	if !got.showDetail {
+		t.Fatal("detail pane closed even though the pinned show survived the refresh")
+	}
+	if got.detailShow.MediaID != 2 {
+		t.Fatalf("detailShow.MediaID = %d, want 2", got.detailShow.MediaID)
+	}
+	if got.filtered[got.cursor].MediaID != 2 {
+		t.Fatalf("cursor points at MediaID %d, want 2", got.filtered[got.cursor].MediaID)
+	}
+}
+
+func TestRefreshClosesDetailWhenPinnedShowDisappears(t *testing.T) {
+	shows := []anilist.ShowInfo{
+		{MediaID: 1, Title: "Frieren", AiringTime: time.Now()},
+	}
+	m := New(nil, shows, nil)
+	m = openDetail(m, 1)
+
+	next, _ := m.Update(refreshMsg{shows: []anilist.ShowInfo{
+		{MediaID: 2, Title: "Dandadan", AiringTime: time.Now()},
+	}})
+	got := next.(Model)
+
+	if got.showDetail {
+		t.Fatal("detail pane stayed open after its pinned show dropped out of the schedule")
+	}
+}
+
+func TestStaleDetailMsgIsIgnored(t *testing.T) {
+	shows := []anilist.ShowInfo{
+		{MediaID: 1, Title: "Frieren", AiringTime: time.Now()},
+		{MediaID: 2, Title: "Dandadan", AiringTime: time.Now()},
+	}
+	m := New(nil, shows, nil)
+	m = openDetail(m, 2) // user is now viewing show 2
+
+	// A slow response for show 1 (e.g. from a pane the user already left)
+	// arrives after the user moved on; it must not clobber m.detail.
+	staleDetail := &anilist.MediaDetail{Title: "Frieren detail"}
+	next, _ := m.Update(detailMsg{mediaID: 1, detail: staleDetail})
+	got := next.(Model)
+
+	if got.detail == staleDetail {
+		t.Fatal("stale detailMsg for a different MediaID was applied")
+	}
+	if !got.loading {
+		t.Fatal("loading flag was cleared by a stale detailMsg")
+	}
+}
+
+func TestClearFiltersResetsWatchingOnly(t *testing.T) {
+	shows := []anilist.ShowInfo{
+		{MediaID: 1, Title: "Frieren", AiringTime: time.Now()},
+	}
+	m := New(nil, shows, nil)
+	m.watchingOnly = true
+	m.applyFilterAndSort()
+
+	next, _ := m.handleKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("c")})
+	got := next.(Model)
+
+	if got.watchingOnly {
+		t.Fatal("'c' left watchingOnly set")
+	}
+}
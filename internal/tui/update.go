@@ -0,0 +1,152 @@
+package tui
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width, m.height = msg.Width, msg.Height
+		return m, nil
+
+	case detailMsg:
+		if !m.showDetail || msg.mediaID != m.detailShow.MediaID {
+			// Stale response for a show the user has since closed or moved
+			// on from; discard it rather than overwrite the current pane.
+			return m, nil
+		}
+		m.loading = false
+		m.detail = msg.detail
+		m.detailErr = msg.err
+		return m, nil
+
+	case refreshMsg:
+		if msg.err != nil {
+			m.refreshErr = msg.err
+			return m, nil
+		}
+		m.shows = msg.shows
+		m.applyFilterAndSort()
+		return m, nil
+
+	case tea.KeyMsg:
+		return m.handleKey(msg)
+	}
+
+	return m, nil
+}
+
+func (m Model) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if m.showDetail {
+		return m.handleDetailKey(msg)
+	}
+	if m.filterMode == FilterSearch {
+		return m.handleSearchKey(msg)
+	}
+
+	switch msg.String() {
+	case "ctrl+c", "q":
+		return m, tea.Quit
+
+	case "up", "k":
+		if m.cursor > 0 {
+			m.cursor--
+		}
+		return m, nil
+
+	case "down", "j":
+		if m.cursor < len(m.filtered)-1 {
+			m.cursor++
+		}
+		return m, nil
+
+	case "enter":
+		if len(m.filtered) == 0 {
+			return m, nil
+		}
+		show := m.filtered[m.cursor]
+		m.showDetail = true
+		m.detailShow = show
+		m.loading = true
+		m.detail = nil
+		m.detailErr = nil
+		return m, m.fetchDetail(show.MediaID)
+
+	case "s":
+		m.sortMode = (m.sortMode + 1) % 3
+		m.applyFilterAndSort()
+		return m, nil
+
+	case "/":
+		m.filterMode = FilterSearch
+		return m, nil
+
+	case "c":
+		m.searchInput = ""
+		m.minScore = 0
+		m.watchingOnly = false
+		m.filterMode = FilterNone
+		m.applyFilterAndSort()
+		return m, nil
+
+	case "+":
+		m.minScore += 10
+		m.applyFilterAndSort()
+		return m, nil
+
+	case "-":
+		m.minScore -= 10
+		if m.minScore < 0 {
+			m.minScore = 0
+		}
+		m.applyFilterAndSort()
+		return m, nil
+
+	case "w":
+		m.watchingOnly = !m.watchingOnly
+		m.applyFilterAndSort()
+		return m, nil
+	}
+
+	return m, nil
+}
+
+// handleDetailKey handles input while the detail pane is open. Filter and
+// sort keys are deliberately not active here: they act on m.filtered, and
+// changing it out from under an open detail pane (or an incoming background
+// refresh) is what left detailView indexing into an emptied slice.
+func (m Model) handleDetailKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "ctrl+c", "q":
+		return m, tea.Quit
+
+	case "esc":
+		m.showDetail = false
+		m.detail = nil
+		m.detailErr = nil
+		return m, nil
+	}
+
+	return m, nil
+}
+
+func (m Model) handleSearchKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyEnter, tea.KeyEsc:
+		m.filterMode = FilterNone
+		m.applyFilterAndSort()
+		return m, nil
+	case tea.KeyBackspace:
+		if len(m.searchInput) > 0 {
+			m.searchInput = m.searchInput[:len(m.searchInput)-1]
+		}
+		m.applyFilterAndSort()
+		return m, nil
+	case tea.KeyRunes:
+		m.searchInput += string(msg.Runes)
+		m.applyFilterAndSort()
+		return m, nil
+	}
+	return m, nil
+}
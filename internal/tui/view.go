@@ -0,0 +1,137 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/umop3plsdn/Aniweek/internal/style"
+)
+
+func (m Model) View() string {
+	if m.showDetail {
+		return style.AppStyle.Render(m.detailView())
+	}
+	return style.AppStyle.Render(m.listView())
+}
+
+func (m Model) listView() string {
+	var b strings.Builder
+
+	b.WriteString(style.DayHeaderStyle.Render("📺 This Week") + "\n")
+	b.WriteString(statusLine(m) + "\n")
+	b.WriteString(style.DividerStyle.String() + "\n")
+
+	if len(m.filtered) == 0 {
+		b.WriteString("No episodes match the current filters.\n")
+	}
+
+	for i, show := range m.filtered {
+		cursor := "  "
+		if i == m.cursor {
+			cursor = "▸ "
+		}
+		line := cursor +
+			style.ScoreEmoji(show.AverageScore) + " " +
+			style.TitleStyle.Render(show.Title) +
+			style.EpisodeStyle.Render(fmt.Sprintf(" Ep %d", show.EpisodeNumber)) +
+			style.TimeStyle.Render(show.AiringTime.Format(" 3:04 PM Jan 02")) +
+			style.RenderScore(show.AverageScore)
+		if show.WatchedProgress != nil {
+			line += style.TimeStyle.Render(fmt.Sprintf(" (watched %d)", *show.WatchedProgress))
+		}
+		if show.IsNew {
+			line += style.EpisodeStyle.Render(" NEW")
+		}
+		b.WriteString(line + "\n")
+	}
+
+	b.WriteString("\n" + helpLine())
+	return b.String()
+}
+
+func statusLine(m Model) string {
+	sortNames := [...]string{"time", "score", "title"}
+	status := fmt.Sprintf("sort: %s", sortNames[m.sortMode])
+	if m.minScore > 0 {
+		status += fmt.Sprintf(" · min score: %d", m.minScore)
+	}
+	if m.filterMode == FilterSearch {
+		status += fmt.Sprintf(" · search: %s_", m.searchInput)
+	} else if m.searchInput != "" {
+		status += fmt.Sprintf(" · search: %s", m.searchInput)
+	}
+	if m.watchingOnly {
+		status += " · watching only"
+	}
+	if m.refreshErr != nil {
+		status += " · refresh failed, showing cached data"
+	}
+	return style.TimeStyle.Render(status)
+}
+
+func helpLine() string {
+	return style.DividerStyle.String() + "\n" +
+		"↑/↓ move · enter detail · s sort · / search · +/- min score · w watching · c clear · q quit"
+}
+
+func (m Model) detailView() string {
+	if !m.showDetail {
+		return "No selection.\n\n" + style.DividerStyle.String() + "\nesc back · q quit"
+	}
+	show := m.detailShow
+
+	var b strings.Builder
+	b.WriteString(style.TitleStyle.Render(show.Title) + "\n")
+	b.WriteString(style.DividerStyle.String() + "\n")
+
+	if m.loading {
+		b.WriteString("Loading detail…\n")
+		return b.String()
+	}
+	if m.detailErr != nil {
+		b.WriteString(style.ErrorStyle.Render(fmt.Sprintf("Couldn't load detail: %v", m.detailErr)) + "\n")
+		return b.String()
+	}
+	if m.detail == nil {
+		b.WriteString("No detail available.\n")
+		return b.String()
+	}
+
+	d := m.detail
+	if len(d.Genres) > 0 {
+		b.WriteString(style.EpisodeStyle.Render(strings.Join(d.Genres, ", ")) + "\n\n")
+	}
+	b.WriteString(wrap(d.Synopsis, 70) + "\n\n")
+	if d.CoverImageURL != "" {
+		b.WriteString(style.TimeStyle.Render("Cover: "+d.CoverImageURL) + "\n")
+	}
+	if d.NextAiringEp > 0 {
+		b.WriteString(style.EpisodeStyle.Render(fmt.Sprintf("Next: Ep %d at %s", d.NextAiringEp, d.NextAiringTime.Format("Mon Jan 02, 3:04 PM"))) + "\n")
+	}
+	if d.SiteURL != "" {
+		b.WriteString(style.TimeStyle.Render("Link: "+d.SiteURL) + "\n")
+	}
+
+	b.WriteString("\n" + style.DividerStyle.String() + "\nesc back · q quit")
+	return b.String()
+}
+
+// wrap does a plain word wrap at width columns; the synopsis text from
+// AniList can otherwise be far wider than a typical terminal.
+func wrap(text string, width int) string {
+	words := strings.Fields(text)
+	var b strings.Builder
+	lineLen := 0
+	for _, word := range words {
+		if lineLen > 0 && lineLen+1+len(word) > width {
+			b.WriteString("\n")
+			lineLen = 0
+		} else if lineLen > 0 {
+			b.WriteString(" ")
+			lineLen++
+		}
+		b.WriteString(word)
+		lineLen += len(word)
+	}
+	return b.String()
+}
@@ -1,252 +1,225 @@
 package main
 
 import (
-	"bytes"
-	"encoding/json"
+	"context"
+	"flag"
 	"fmt"
-	"io/ioutil"
-	"net/http"
-	"sort"
-	"strings"
+	"os"
+	"strconv"
 	"time"
 
-	"github.com/charmbracelet/lipgloss"
+	"github.com/umop3plsdn/Aniweek/internal/anilist"
+	"github.com/umop3plsdn/Aniweek/internal/cache"
+	"github.com/umop3plsdn/Aniweek/internal/calendar"
+	"github.com/umop3plsdn/Aniweek/internal/export"
+	"github.com/umop3plsdn/Aniweek/internal/provider"
+	"github.com/umop3plsdn/Aniweek/internal/render"
+	"github.com/umop3plsdn/Aniweek/internal/tui"
 )
 
-// Neon Charm-inspired color palette
-var (
-	primaryColor   = lipgloss.Color("#FF5FEF") // Neon pink
-	secondaryColor = lipgloss.Color("#00F8D4") // Electric teal
-	accentColor    = lipgloss.Color("#BD93FF") // Neon purple
-	textColor      = lipgloss.AdaptiveColor{Light: "#E0E0E0", Dark: "#E0E0E0"}
-	subtleColor    = lipgloss.Color("#A0A0A0")
-	highlightColor = lipgloss.Color("#FFB86C") // Neon peach
-)
-
-// Define all our styles
-var (
-	// Text elements
-	titleStyle = lipgloss.NewStyle().
-			Foreground(primaryColor).
-			Bold(true).
-			MarginRight(1)
-
-	episodeStyle = lipgloss.NewStyle().
-			Foreground(secondaryColor).
-			Bold(true)
-
-	timeStyle = lipgloss.NewStyle().
-			Foreground(subtleColor).
-			PaddingLeft(1)
-
-	scoreStyle = lipgloss.NewStyle().
-			Foreground(highlightColor).
-			PaddingLeft(1)
-
-	noScoreStyle = lipgloss.NewStyle().
-			Foreground(subtleColor).
-			Italic(true).
-			PaddingLeft(1)
-
-	// Day headers
-	dayHeaderStyle = lipgloss.NewStyle().
-			Foreground(accentColor).
-			Bold(true).
-			MarginTop(1).
-			Underline(true).
-			PaddingBottom(0)
-
-	dividerStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.AdaptiveColor{Light: "#D9D9D9", Dark: "#444"}).
-			SetString("╌╌╌╌╌╌╌╌╌╌╌╌╌╌╌╌╌╌╌╌╌╌╌╌╌╌╌")
-
-	// Containers
-	showEntryStyle = lipgloss.NewStyle().
-			PaddingLeft(2).
-			MarginBottom(0)
-
-	appStyle = lipgloss.NewStyle().
-			Padding(1, 2).
-			Border(lipgloss.RoundedBorder()).
-			BorderForeground(lipgloss.AdaptiveColor{Light: "#BD93FF", Dark: "#BD93FF"}).
-			Foreground(textColor)
-)
-
-type GraphQLRequest struct {
-	Query string `json:"query"`
-}
-
-type GraphQLResponse struct {
-	Data struct {
-		Page struct {
-			AiringSchedules []AiringSchedule `json:"airingSchedules"`
-		} `json:"Page"`
-	} `json:"data"`
-}
-
-type AiringSchedule struct {
-	Episode   int `json:"episode"`
-	AiringAt int `json:"airingAt"`
-	Media     struct {
-		Title struct {
-			Romaji  string `json:"romaji"`
-			English string `json:"english"`
-		} `json:"title"`
-		AverageScore int `json:"averageScore"`
-	} `json:"media"`
-}
-
-type ShowInfo struct {
-	Title         string
-	EpisodeNumber int
-	AverageScore  int
-	AiringTime    time.Time
-}
+// cacheFreshness is how long a cached fetch is trusted before a non-TUI run
+// refetches on its own; --refresh bypasses this.
+const cacheFreshness = 10 * time.Minute
 
 func main() {
-	now := time.Now().UTC()
-	sevenDaysAgo := now.AddDate(0, 0, -7)
-
-	query := fmt.Sprintf(`
-	{
-		Page(perPage: 100) {
-			airingSchedules(airingAt_greater: %d, airingAt_lesser: %d, sort: TIME_DESC) {
-				episode
-				airingAt
-				media {
-					title {
-						romaji
-						english
-					}
-					averageScore
-				}
-			}
-		}
+	if len(os.Args) > 1 && os.Args[1] == "notify" {
+		runNotify(os.Args[2:])
+		return
 	}
-	`, sevenDaysAgo.Unix(), now.Unix())
 
-	requestBody, err := json.Marshal(GraphQLRequest{Query: query})
+	output := flag.String("output", "tui", "output format: tui (interactive), plain, calendar, json, csv, or ical")
+	mine := flag.Bool("mine", false, "restrict the schedule to shows on your AniList CURRENT/PLANNING lists (requires login; not applied with --offline, to the TUI's cached first screen, or to cached data shown after a failed refresh)")
+	offline := flag.Bool("offline", false, "use cached data only, without touching the network")
+	forceRefresh := flag.Bool("refresh", false, "bypass the cache freshness window and refetch immediately")
+	providerSpec := flag.String("provider", "anilist", "comma-separated data source(s) to pull the schedule from: anilist, mal, kitsu, ics")
+	icsSource := flag.String("ics", "", "file path or URL of the iCalendar feed to use with --provider=ics")
+	flag.Parse()
+
+	ctx := context.Background()
+	client := anilist.NewClient()
+
+	providers, err := provider.Parse(*providerSpec, client, *icsSource)
 	if err != nil {
-		printError("Error creating request", err)
+		fmt.Println(render.Error("Error configuring provider", err))
 		return
 	}
 
-	resp, err := http.Post("https://graphql.anilist.co", "application/json", bytes.NewBuffer(requestBody))
-	if err != nil {
-		printError("Error making request", err)
-		return
+	if *mine && !*offline {
+		if err := ensureLoggedIn(ctx, client); err != nil {
+			fmt.Println(render.Error("Error logging in to AniList", err))
+			return
+		}
 	}
-	defer resp.Body.Close()
 
-	body, err := ioutil.ReadAll(resp.Body)
+	store, err := cache.Open()
 	if err != nil {
-		printError("Error reading response", err)
+		fmt.Println(render.Error("Error opening local cache", err))
 		return
 	}
+	defer store.Close()
 
-	var response GraphQLResponse
-	if err := json.Unmarshal(body, &response); err != nil {
-		printError("Error parsing response", err)
+	stale, err := store.LoadShows()
+	if err != nil {
+		fmt.Println(render.Error("Error reading local cache", err))
 		return
 	}
+	haveBaseline := !store.LastFetchedAt().IsZero()
 
-	showsByDay := organizeShowsByDay(response.Data.Page.AiringSchedules)
-	if len(showsByDay) == 0 {
-		fmt.Println(appStyle.Render("✨ No new episodes aired in the past week ✨"))
+	if *offline {
+		shows := stale
+		if len(shows) == 0 {
+			fmt.Println(render.Error("No offline data available", fmt.Errorf("run aniweek online at least once before using --offline")))
+			return
+		}
+		printOrRun(client, shows, *output, nil)
 		return
 	}
 
-	renderOutput(showsByDay)
-}
+	fetch := func(prevRaw []anilist.ShowInfo) ([]anilist.ShowInfo, []anilist.ShowInfo, error) {
+		now := time.Now().UTC()
+		sevenDaysAgo := now.AddDate(0, 0, -7)
 
-func organizeShowsByDay(schedules []AiringSchedule) map[time.Time][]ShowInfo {
-	showsByDay := make(map[time.Time][]ShowInfo)
+		raw, err := provider.FetchAll(ctx, providers, sevenDaysAgo, now)
+		if err != nil {
+			return nil, nil, err
+		}
 
-	for _, schedule := range schedules {
-		title := schedule.Media.Title.English
-		if title == "" {
-			title = schedule.Media.Title.Romaji
+		annotatedRaw := raw
+		if haveBaseline {
+			annotatedRaw = cache.NewSince(prevRaw, raw)
+		}
+		if err := store.SaveShows(raw); err != nil {
+			return nil, nil, err
 		}
 
-		airTime := time.Unix(int64(schedule.AiringAt), 0).UTC()
-		dayKey := time.Date(airTime.Year(), airTime.Month(), airTime.Day(), 0, 0, 0, 0, time.UTC)
+		shows := annotatedRaw
+		if *mine {
+			shows, err = restrictToViewerLists(ctx, client, shows)
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+		return shows, raw, nil
+	}
 
-		showsByDay[dayKey] = append(showsByDay[dayKey], ShowInfo{
-			Title:         title,
-			EpisodeNumber: schedule.Episode,
-			AverageScore:  schedule.Media.AverageScore,
-			AiringTime:    airTime,
-		})
+	if *output != "tui" {
+		needsFetch := *forceRefresh || !haveBaseline || time.Since(store.LastFetchedAt()) > cacheFreshness
+		if !needsFetch {
+			shows := stale
+			if *mine {
+				shows, err = restrictToViewerLists(ctx, client, stale)
+				if err != nil {
+					fmt.Println(render.Error("Error fetching your AniList lists", err))
+					return
+				}
+			}
+			printOrRun(client, shows, *output, nil)
+			return
+		}
+
+		shows, _, err := fetch(stale)
+		if err != nil {
+			if len(stale) > 0 {
+				fmt.Println(render.Error("Error refreshing schedule, showing cached data", err))
+				printOrRun(client, stale, *output, nil)
+				return
+			}
+			fmt.Println(render.Error("Error fetching weekly schedule", err))
+			return
+		}
+		printOrRun(client, shows, *output, nil)
+		return
 	}
 
-	return showsByDay
+	// Interactive TUI: show cached data instantly, refresh in the background.
+	refresh := func(_ []anilist.ShowInfo) ([]anilist.ShowInfo, error) {
+		shows, _, err := fetch(stale)
+		return shows, err
+	}
+	if err := tui.Run(client, stale, refresh); err != nil {
+		fmt.Println(render.Error("Error running TUI", err))
+	}
 }
 
-func renderOutput(showsByDay map[time.Time][]ShowInfo) {
-	var output strings.Builder
-
-	// Get sorted days
-	days := make([]time.Time, 0, len(showsByDay))
-	for day := range showsByDay {
-		days = append(days, day)
-	}
-	// Sort in reverse chronological order
-	sort.Slice(days, func(i, j int) bool {
-		return days[i].After(days[j])
-	})
-
-	// Build output with enhanced styling
-	for i, day := range days {
-		shows := showsByDay[day]
-		dayFormatted := day.Format("Monday (Jan 02)")
-
-		// Day header with subtle divider
-		header := dayHeaderStyle.Render("📺 " + dayFormatted)
-		output.WriteString(header + "\n")
-		output.WriteString(dividerStyle.String() + "\n")
-
-		// Shows for this day
-		for _, show := range shows {
-			emoji := "✨"
-			if show.AverageScore > 75 {
-				emoji = "🌟"
-			} else if show.AverageScore == 0 {
-				emoji = "📡"
-			}
+// printOrRun renders shows in the requested output format, or launches the
+// interactive TUI when output is "tui".
+func printOrRun(client *anilist.Client, shows []anilist.ShowInfo, output string, refresh tui.RefreshFunc) {
+	switch output {
+	case "tui":
+		if err := tui.Run(client, shows, refresh); err != nil {
+			fmt.Println(render.Error("Error running TUI", err))
+		}
+	case "calendar":
+		fmt.Println(calendar.New(shows).Render(termWidth()))
+	default:
+		encoder, err := export.ForName(output)
+		if err != nil {
+			fmt.Println(render.Error("Error selecting output format", err))
+			return
+		}
+		encoded, err := encoder.Encode(shows)
+		if err != nil {
+			fmt.Println(render.Error("Error encoding output", err))
+			return
+		}
+		fmt.Println(encoded)
+	}
+}
 
-			entry := showEntryStyle.Render(
-				emoji + " " +
-					titleStyle.Render(show.Title) +
-					lipgloss.NewStyle().Foreground(subtleColor).Render(" • ") +
-					episodeStyle.Render(fmt.Sprintf("Ep %d", show.EpisodeNumber)) +
-					timeStyle.Render(fmt.Sprintf(" 🕒 %s", show.AiringTime.Format("3:04 PM"))) +
-					renderScore(show.AverageScore),
-			)
-			output.WriteString(entry + "\n")
+// termWidth returns the terminal width to lay the calendar view out for,
+// honoring $COLUMNS if set and falling back to a conservative default.
+func termWidth() int {
+	if cols := os.Getenv("COLUMNS"); cols != "" {
+		if width, err := strconv.Atoi(cols); err == nil && width > 0 {
+			return width
 		}
+	}
+	return 80
+}
 
-		// Add space between days (but not after last day)
-		if i < len(days)-1 {
-			output.WriteString("\n")
+// ensureLoggedIn makes sure client.Token holds a valid access token, loading
+// the cached token, refreshing it if it's expired, or running the full
+// OAuth login flow if no cached token exists.
+func ensureLoggedIn(ctx context.Context, client *anilist.Client) error {
+	token, err := anilist.LoadToken()
+	if err != nil {
+		token, err = client.Login(ctx)
+		if err != nil {
+			return err
 		}
+		client.Token = token
+		return nil
 	}
 
-	// Final render with beautiful border
-	fmt.Println(appStyle.Render(output.String()))
+	client.Token = token
+	if token.Expired() {
+		if _, err := client.RefreshToken(ctx, token); err != nil {
+			newToken, loginErr := client.Login(ctx)
+			if loginErr != nil {
+				return loginErr
+			}
+			client.Token = newToken
+		}
+	}
+	return nil
 }
 
-func renderScore(score int) string {
-	if score > 0 {
-		return scoreStyle.Render(fmt.Sprintf("★ %.0f/100", float32(score)))
+// restrictToViewerLists narrows shows down to the ones on the logged-in
+// user's CURRENT or PLANNING lists, annotated with watch progress.
+func restrictToViewerLists(ctx context.Context, client *anilist.Client, shows []anilist.ShowInfo) ([]anilist.ShowInfo, error) {
+	viewer, err := client.Viewer(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := client.UserWatchList(ctx, viewer.ID, "CURRENT")
+	if err != nil {
+		return nil, err
+	}
+	planning, err := client.UserWatchList(ctx, viewer.ID, "PLANNING")
+	if err != nil {
+		return nil, err
 	}
-	return noScoreStyle.Render("★ No rating")
-}
 
-func printError(context string, err error) {
-	errorStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("#FF6B6B")).
-		Bold(true)
-	
-	fmt.Println(errorStyle.Render(fmt.Sprintf("%s: %v", context, err)))
+	return anilist.RestrictToWatchList(shows, append(current, planning...)), nil
 }
-